@@ -2,11 +2,16 @@ package main
 
 import (
 	"context"
+	"encoding/csv"
 	"fmt"
+	"io"
 	"log"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"sync"
+	"unicode"
 	"xchess-desktop/internal/auth"
 	"xchess-desktop/internal/database"
 
@@ -19,6 +24,7 @@ import (
 // App struct is the main application structure for Wails
 type App struct {
 	ctx               context.Context
+	mu                sync.Mutex // Guards currentTournament; held for a method's whole read-modify-write sequence
 	currentTournament *model.Tournament
 	engine            tournament.PairingEngine
 	db                *database.DB
@@ -34,8 +40,8 @@ func NewApp() *App {
 func (a *App) startup(ctx context.Context) {
 	a.ctx = ctx
 
-	// Initialize default pairing engine (Swiss)
-	a.engine = tournament.SwissToolAdapter{}
+	// Initialize the pairing engine (Swiss until a tournament says otherwise)
+	a.resolveEngine()
 
 	// Initialize database and services
 	dbPath, err := database.GetDBPath()
@@ -95,9 +101,31 @@ func (a *App) CheckAdminCredentials(username, password string) (bool, error) {
 	return ok, nil
 }
 
+// requireRole checks that the acting user holds the given role before a destructive operation.
+// Without an auth service nobody can be verified, so the operation is denied.
+func (a *App) requireRole(username string, role model.Role) error {
+	if a.authSvc == nil {
+		return &auth.PermissionError{Username: username, Required: role}
+	}
+	return a.authSvc.RequireRole(username, role)
+}
+
+// CreateAdmin creates an additional administrator account. Requires SUDO.
+func (a *App) CreateAdmin(actingUsername string, username string, password string, role string) (bool, error) {
+	if err := a.requireRole(actingUsername, model.Sudo); err != nil {
+		return false, err
+	}
+	if err := a.authSvc.CreateAdmin(username, password, model.Role(role)); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 // Initialize a new tournament with a title and player names.
 // Returns true if initialization succeeded.
 func (a *App) InitTournament(title string, description string, playerNames []string) (bool, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
 	players := make([]model.Player, 0, len(playerNames))
 	for _, name := range playerNames {
 		players = append(players, model.Player{
@@ -121,12 +149,15 @@ func (a *App) InitTournament(title string, description string, playerNames []str
 		return false, err
 	}
 	a.currentTournament = t
+	a.resolveEngine()
 	return true, nil
 }
 
 // Advance to the next round and generate pairings.
 // Returns true if the round was generated.
 func (a *App) NextRound() (bool, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
 	if a.currentTournament == nil {
 		return false, nil
 	}
@@ -136,8 +167,31 @@ func (a *App) NextRound() (bool, error) {
 	return true, nil
 }
 
+// LockPairingSeed fixes the round 1 pairing seed before previewing, so NextRound pairs exactly
+// what PreviewNextRound shows. Returns the seed.
+func (a *App) LockPairingSeed() (int64, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return 0, nil
+	}
+	return tournament.LockPairingSeed(a.currentTournament)
+}
+
+// PreviewNextRound returns the proposed pairings for the next round without saving them.
+func (a *App) PreviewNextRound() (model.Round, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return model.Round{}, nil
+	}
+	return tournament.PreviewNextRound(a.currentTournament, a.engine)
+}
+
 // Get the current round matches.
 func (a *App) GetCurrentRound() (model.Round, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
 	var empty model.Round
 	if a.currentTournament == nil {
 		return empty, nil
@@ -156,8 +210,10 @@ func (a *App) GetCurrentRound() (model.Round, error) {
 }
 
 // Record a result for a given table in the current round.
-// result must be one of: "A_WIN", "B_WIN", "DRAW", "BYE_A".
-func (a *App) RecordResult(tableNumber int, result string) (bool, error) {
+// result must be one of: "A_WIN", "B_WIN", "DRAW", "BYE_A", "BYE_B", "A_FORFEIT", "B_FORFEIT", "DOUBLE_FORFEIT".
+func (a *App) RecordResult(tableNumber int, result model.MatchResult) (bool, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
 	if a.currentTournament == nil {
 		return false, nil
 	}
@@ -168,8 +224,39 @@ func (a *App) RecordResult(tableNumber int, result string) (bool, error) {
 	return true, nil
 }
 
+// RepairRemainingMatches re-pairs the unplayed games of the current round, keeping the
+// recorded results.
+func (a *App) RepairRemainingMatches(roundNumber int) (bool, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return false, nil
+	}
+	if err := tournament.RepairRemainingMatches(a.currentTournament, roundNumber); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// GetValidResults returns the result codes that may be recorded for the match at a table,
+// so the UI only offers buttons that RecordResult will accept.
+func (a *App) GetValidResults(roundNumber int, tableNumber int) ([]model.MatchResult, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return []model.MatchResult{}, nil
+	}
+	match, _, err := tournament.FindMatch(a.currentTournament, roundNumber, tableNumber)
+	if err != nil {
+		return nil, err
+	}
+	return tournament.ValidResultsFor(*match), nil
+}
+
 // Get the current players (including scores and buchholz).
 func (a *App) GetPlayers() ([]model.Player, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
 	if a.currentTournament == nil {
 		return []model.Player{}, nil
 	}
@@ -178,306 +265,1781 @@ func (a *App) GetPlayers() ([]model.Player, error) {
 
 // GetStandings returns sorted standings for the active tournament.
 func (a *App) GetStandings() ([]model.Player, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
 	if a.currentTournament == nil {
 		return []model.Player{}, nil
 	}
 	return tournament.GetStandings(a.currentTournament)
 }
 
+// GetStandingsWithMovement returns the standings with each player's rank change since the previous round.
+func (a *App) GetStandingsWithMovement() ([]tournament.PlayerStandingWithDelta, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return []tournament.PlayerStandingWithDelta{}, nil
+	}
+	return tournament.GetStandingsWithMovement(a.currentTournament)
+}
+
+// ProjectStandings returns what-if standings for results (table -> result) in the current round,
+// without changing the tournament.
+func (a *App) ProjectStandings(hypothetical map[int]model.MatchResult) ([]model.Player, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return []model.Player{}, nil
+	}
+	return tournament.ProjectStandings(a.currentTournament, hypothetical)
+}
+
 // Optionally expose basic tournament info for the frontend.
 func (a *App) GetTournamentInfo() (model.Tournament, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
 	if a.currentTournament == nil {
 		return model.Tournament{}, nil
 	}
 	return *a.currentTournament, nil
 }
 
-// ListPlayers returns all players (peserta) from the database for selection in the frontend.
-func (a *App) ListPlayers() ([]model.Player, error) {
-	if a.db == nil {
-		return []model.Player{}, nil
-	}
-	var players []model.Player
-	if err := a.db.Find(&players).Error; err != nil {
-		return []model.Player{}, err
+// GetTimings returns the elapsed time of the active tournament and each of its rounds.
+func (a *App) GetTimings() (tournament.TournamentTimings, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return tournament.TournamentTimings{}, nil
 	}
-	return players, nil
+	return tournament.GetTimings(a.currentTournament)
 }
 
-// Initialize a new tournament using selected existing player IDs.
-// No player creation; we load players from the DB and initialize the tournament.
-func (a *App) InitTournamentWithPlayerIDs(title string, description string, playerIDs []string) (bool, error) {
-	if a.db == nil {
+// RecommendRounds returns the suggested number of Swiss rounds for a field of playerCount players.
+func (a *App) RecommendRounds(playerCount int) int {
+	return tournament.RecommendRounds(playerCount)
+}
+
+// SetRoundsTotal changes the number of rounds of the current tournament; extending a
+// complete tournament reopens it.
+func (a *App) SetRoundsTotal(rounds int) (bool, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
 		return false, nil
 	}
-	var players []model.Player
-	if err := a.db.Where("id IN ?", playerIDs).Find(&players).Error; err != nil {
+	if err := tournament.SetRoundsTotal(a.currentTournament, rounds); err != nil {
 		return false, err
 	}
-	if len(players) == 0 {
-		return false, nil
+	return true, nil
+}
+
+// GetPodium returns the top three finishers (more when third place is tied).
+func (a *App) GetPodium() ([]model.Player, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return []model.Player{}, nil
 	}
+	return tournament.GetPodium(a.currentTournament)
+}
 
-	t := &model.Tournament{
-		ByeScore:      1.0,
-		PairingSystem: "SWISS",
+// GetTeamStandings returns the team standings of the current tournament.
+func (a *App) GetTeamStandings() ([]tournament.TeamStanding, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return []tournament.TeamStanding{}, nil
 	}
-	if err := tournament.InitializeTournament(t, title, description, players); err != nil {
-		return false, err
+	return tournament.GetTeamStandings(a.currentTournament)
+}
+
+// GetClubStandings returns the clubs of the current tournament ranked by their members' scores.
+func (a *App) GetClubStandings() ([]tournament.ClubStanding, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return []tournament.ClubStanding{}, nil
 	}
-	a.currentTournament = t
-	return true, nil
+	return tournament.GetClubStandings(a.currentTournament)
 }
 
-// CancelCurrentRound cancels the current round and reverts to the previous round state.
-// Returns true if the round was successfully cancelled.
-func (a *App) CancelCurrentRound() (bool, error) {
+// SetClubScoringBoards sets how many of each club's best members count in the club standings (0 = all).
+func (a *App) SetClubScoringBoards(boards int) (bool, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
 	if a.currentTournament == nil {
 		return false, nil
 	}
-	if err := tournament.CancelCurrentRound(a.currentTournament); err != nil {
+	if err := tournament.SetClubScoringBoards(a.currentTournament, boards); err != nil {
 		return false, err
 	}
 	return true, nil
 }
 
-// ExportRoundPairingsToPDF exports the pairings for a specific round to PDF.
-// Returns the PDF data as bytes.
-func (a *App) ExportRoundPairingsToPDF(roundNumber int) ([]byte, error) {
+// GetTeamMatches returns the team matches of a round (0 for every round).
+func (a *App) GetTeamMatches(roundNumber int) ([]tournament.TeamMatch, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
 	if a.currentTournament == nil {
-		return nil, nil
+		return []tournament.TeamMatch{}, nil
 	}
-	return tournament.ExportRoundPairingsToPDF(a.currentTournament, roundNumber)
+	return tournament.GetTeamMatches(a.currentTournament, roundNumber)
 }
 
-// SaveRoundPairingsToPDF exports round pairings to PDF and saves to Desktop.
-// Returns the file path where the PDF was saved.
-func (a *App) SaveRoundPairingsToPDF(roundNumber int) (string, error) {
-	if a.currentTournament == nil {
-		return "", fmt.Errorf("no active tournament")
-	}
-	
-	// Generate PDF bytes
-	pdfBytes, err := tournament.ExportRoundPairingsToPDF(a.currentTournament, roundNumber)
-	if err != nil {
-		return "", fmt.Errorf("failed to generate PDF: %w", err)
+// SetPlayerTeam assigns a player to a team in the database and the current tournament.
+func (a *App) SetPlayerTeam(id string, team string) (bool, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	team = strings.TrimSpace(team)
+
+	found := false
+	if a.db != nil {
+		result := a.db.Model(&model.Player{}).Where("id = ?", id).Update("team", team)
+		if result.Error != nil {
+			return false, fmt.Errorf("failed to update player in database: %v", result.Error)
+		}
+		found = result.RowsAffected > 0
 	}
-	
-	// Get user's Desktop directory
-	homeDir, err := os.UserHomeDir()
-	if err != nil {
-		return "", fmt.Errorf("failed to get home directory: %w", err)
+
+	if a.currentTournament != nil {
+		players, err := a.currentTournament.GetPlayers()
+		if err != nil {
+			return false, err
+		}
+		for _, p := range players {
+			if p.ID == id {
+				if err := tournament.SetPlayerTeam(a.currentTournament, id, team); err != nil {
+					return false, err
+				}
+				found = true
+				break
+			}
+		}
 	}
-	
-	desktopDir := filepath.Join(homeDir, "Desktop")
-	
-	// Create filename
-	fileName := fmt.Sprintf("Ronde_%d_%s.pdf", roundNumber, 
-		strings.ReplaceAll(a.currentTournament.Title, " ", "_"))
-	filePath := filepath.Join(desktopDir, fileName)
-	
-	// Write file to Desktop
-	err = os.WriteFile(filePath, pdfBytes, 0644)
-	if err != nil {
-		return "", fmt.Errorf("failed to save PDF file: %w", err)
+
+	if !found {
+		return false, fmt.Errorf("player not found: %s", id)
 	}
-	
-	return filePath, nil
+	return true, nil
 }
 
-// ExportAllRoundsPairingsToPDF exports all rounds pairings to a single PDF.
-// Returns the PDF data as bytes.
-func (a *App) ExportAllRoundsPairingsToPDF() ([]byte, error) {
+// GetMatch returns a single match of a round with its players' names resolved.
+func (a *App) GetMatch(roundNumber int, tableNumber int) (tournament.MatchDetail, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
 	if a.currentTournament == nil {
-		return nil, nil
+		return tournament.MatchDetail{}, fmt.Errorf("no active tournament")
 	}
-	return tournament.ExportAllRoundsPairingsToPDF(a.currentTournament)
+	return tournament.GetMatch(a.currentTournament, roundNumber, tableNumber)
 }
 
-// SaveAllRoundsPairingsToPDF exports all rounds pairings to PDF and saves to Desktop.
-// Returns the file path where the PDF was saved.
-func (a *App) SaveAllRoundsPairingsToPDF() (string, error) {
+// GetAuditLog returns every event of the current tournament in chronological order.
+func (a *App) GetAuditLog() ([]model.Event, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
 	if a.currentTournament == nil {
-		return "", fmt.Errorf("no active tournament")
+		return []model.Event{}, nil
 	}
-	
-	// Generate PDF bytes
-	pdfBytes, err := tournament.ExportAllRoundsPairingsToPDF(a.currentTournament)
-	if err != nil {
-		return "", fmt.Errorf("failed to generate PDF: %w", err)
-	}
-	
-	// Get user's Desktop directory
-	homeDir, err := os.UserHomeDir()
-	if err != nil {
-		return "", fmt.Errorf("failed to get home directory: %w", err)
-	}
-	
-	desktopDir := filepath.Join(homeDir, "Desktop")
-	
-	// Create filename
-	fileName := fmt.Sprintf("Semua_Ronde_%s.pdf", 
-		strings.ReplaceAll(a.currentTournament.Title, " ", "_"))
-	filePath := filepath.Join(desktopDir, fileName)
-	
-	// Write file to Desktop
-	err = os.WriteFile(filePath, pdfBytes, 0644)
-	if err != nil {
-		return "", fmt.Errorf("failed to save PDF file: %w", err)
-	}
-	
-	return filePath, nil
+	return tournament.GetAuditLog(a.currentTournament)
 }
 
-// ExportStandingsToPDF exports the tournament standings to PDF.
-// Returns the PDF data as bytes.
-func (a *App) ExportStandingsToPDF() ([]byte, error) {
+// GetPlayerHistory returns a player's opponent, color, result and running score per round.
+func (a *App) GetPlayerHistory(playerID string) ([]tournament.PlayerRoundResult, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
 	if a.currentTournament == nil {
-		return nil, nil
+		return []tournament.PlayerRoundResult{}, nil
 	}
-	return tournament.ExportStandingsToPDF(a.currentTournament)
+	return tournament.GetPlayerHistory(a.currentTournament, playerID)
 }
 
-// SaveStandingsToPDF exports tournament standings to PDF and saves to Desktop.
-// Returns the file path where the PDF was saved.
-func (a *App) SaveStandingsToPDF() (string, error) {
+// MyPairing is a player's pairing in the current round, for the pairing lookup screen.
+type MyPairing struct {
+	RoundNumber  int    `json:"round_number"`
+	TableNumber  int    `json:"table_number"`
+	OpponentID   string `json:"opponent_id"` // "BYE" for a bye
+	OpponentName string `json:"opponent_name"`
+	Color        string `json:"color"` // "W" or "B"; empty for a bye
+	Bye          bool   `json:"bye"`
+}
+
+// GetMyPairing looks up who the player faces in the current round, with which color and on which table.
+func (a *App) GetMyPairing(playerID string) (MyPairing, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
 	if a.currentTournament == nil {
-		return "", fmt.Errorf("no active tournament")
+		return MyPairing{}, fmt.Errorf("no active tournament")
 	}
-	
-	// Generate PDF bytes
-	pdfBytes, err := tournament.ExportStandingsToPDF(a.currentTournament)
+	round := a.currentTournament.CurrentRound
+	opponentID, color, table, err := tournament.GetOpponentFor(a.currentTournament, round, playerID)
 	if err != nil {
-		return "", fmt.Errorf("failed to generate PDF: %w", err)
+		return MyPairing{}, err
 	}
-	
-	// Get user's Desktop directory
-	homeDir, err := os.UserHomeDir()
-	if err != nil {
-		return "", fmt.Errorf("failed to get home directory: %w", err)
+	pairing := MyPairing{
+		RoundNumber: round,
+		TableNumber: table,
+		OpponentID:  opponentID,
+		Color:       color,
+		Bye:         opponentID == tournament.ByePlayerID,
 	}
-	
-	desktopDir := filepath.Join(homeDir, "Desktop")
-	
-	// Create filename
-	fileName := fmt.Sprintf("Klasemen_%s.pdf", 
-		strings.ReplaceAll(a.currentTournament.Title, " ", "_"))
-	filePath := filepath.Join(desktopDir, fileName)
-	
-	// Write file to Desktop
-	err = os.WriteFile(filePath, pdfBytes, 0644)
-	if err != nil {
-		return "", fmt.Errorf("failed to save PDF file: %w", err)
+	if pairing.Bye {
+		pairing.OpponentName = "BYE"
+	} else {
+		match, err := tournament.GetMatch(a.currentTournament, round, table)
+		if err != nil {
+			return MyPairing{}, err
+		}
+		pairing.OpponentName = match.PlayerAName
+		if match.PlayerA_ID == playerID {
+			pairing.OpponentName = match.PlayerBName
+		}
 	}
-	
-	return filePath, nil
+	return pairing, nil
 }
 
-// AddPlayer adds a new player to the database and optionally to the current tournament.
-// Returns the player ID if successful.
-func (a *App) AddPlayer(name string, club string) (string, error) {
-	// Validate required fields
-	if strings.TrimSpace(name) == "" {
-		return "", fmt.Errorf("player name is required")
+// GetTournamentStats returns aggregate numbers (games, draws, byes, scores, rounds) for the dashboard.
+func (a *App) GetTournamentStats() (tournament.TournamentStats, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return tournament.TournamentStats{}, nil
 	}
+	return tournament.GetTournamentStats(a.currentTournament)
+}
 
-	log.Printf("AddPlayer called: name='%s', club='%s'", name, club)
-
-	// Generate new UUID for the player
-	playerID := uuid.NewString()
-
-	// Create new player
-	newPlayer := model.Player{
-		ID:           playerID,
-		Name:         strings.TrimSpace(name),
-		Score:        0.0,
-		OpponentIDs:  []string{},
-		Buchholz:     0.0,
-		ColorHistory: "",
-		HasBye:       false,
-		Club:         strings.TrimSpace(club),
+// GetRoundRemainingSeconds returns the clock time left in the given round.
+func (a *App) GetRoundRemainingSeconds(roundNumber int) (int, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return 0, nil
 	}
+	return tournament.GetRoundRemainingSeconds(a.currentTournament, roundNumber)
+}
 
-	// Save to database with transaction for better reliability
-	if a.db != nil {
-		// Use transaction to ensure atomicity
-		tx := a.db.Begin()
-		if tx.Error != nil {
-			log.Printf("Failed to begin transaction: %v", tx.Error)
-			return "", fmt.Errorf("failed to begin transaction: %v", tx.Error)
-		}
-
-		if err := tx.Create(&newPlayer).Error; err != nil {
-			tx.Rollback()
-			log.Printf("Failed to save player to database: %v", err)
-			return "", fmt.Errorf("failed to save player to database: %v", err)
-		}
-
-		// Force commit and sync to disk (important for Windows)
-		if err := tx.Commit().Error; err != nil {
-			log.Printf("Failed to commit transaction: %v", err)
-			return "", fmt.Errorf("failed to commit transaction: %v", err)
-		}
-
-		// Additional sync for Windows - force write to disk
-		if err := a.db.Exec("PRAGMA synchronous = FULL").Error; err != nil {
-			log.Printf("Warning: Failed to set synchronous mode: %v", err)
-		}
-
-		log.Printf("Player saved to database successfully: ID=%s", playerID)
-	} else {
-		log.Printf("Warning: Database is nil, player not saved to database")
+// GetRoundProgress reports how many results of a round are in and when the last one arrived.
+func (a *App) GetRoundProgress(roundNumber int) (tournament.RoundProgress, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return tournament.RoundProgress{}, nil
 	}
+	return tournament.GetRoundProgress(a.currentTournament, roundNumber)
+}
 
-	// If there's an active tournament and it hasn't started, add player to tournament
-	if a.currentTournament != nil {
-		log.Printf("Current tournament exists, CurrentRound=%d", a.currentTournament.CurrentRound)
-		if a.currentTournament.CurrentRound == 0 {
-			if _, err := tournament.AddPlayer(a.currentTournament, name, club); err != nil {
-				// Player saved to DB but failed to add to tournament - that's okay
-				log.Printf("Player saved to DB but failed to add to tournament: %v", err)
-			} else {
-				log.Printf("Player added to tournament successfully")
-			}
-		} else {
-			log.Printf("Tournament has already started (round %d), player not added to tournament", a.currentTournament.CurrentRound)
-		}
-	} else {
-		log.Printf("No active tournament, player only saved to database")
+// GetPendingMatches lists the matches of a round that have no result yet, by table.
+func (a *App) GetPendingMatches(roundNumber int) ([]tournament.MatchDetail, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return []tournament.MatchDetail{}, nil
 	}
-
-	return playerID, nil
+	return tournament.GetPendingMatches(a.currentTournament, roundNumber)
 }
 
-// ClearMatchResult clears the result of a specific match
-func (a *App) ClearMatchResult(roundNumber int, tableNumber int) (bool, error) {
+// FinalizeRound checks that every result of a round is in, marks it complete and logs
+// ROUND_FINALIZED with a standings snapshot.
+func (a *App) FinalizeRound(roundNumber int) (bool, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
 	if a.currentTournament == nil {
 		return false, nil
 	}
-	if err := tournament.ClearMatchResult(a.currentTournament, roundNumber, tableNumber); err != nil {
+	if err := tournament.FinalizeRound(a.currentTournament, roundNumber); err != nil {
 		return false, err
 	}
 	return true, nil
 }
 
-// ClearAllResultsInRound clears all results in a specific round
-func (a *App) ClearAllResultsInRound(roundNumber int) (bool, error) {
+// GetRemainingPairings returns how many pairs of active players have not met yet.
+func (a *App) GetRemainingPairings() (int, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
 	if a.currentTournament == nil {
-		return false, nil
-	}
-	if err := tournament.ClearAllResultsInRound(a.currentTournament, roundNumber); err != nil {
-		return false, err
+		return 0, nil
+	}
+	return tournament.RemainingPairings(a.currentTournament)
+}
+
+// GetColorBalance returns Whites minus Blacks per player ID.
+func (a *App) GetColorBalance() (map[string]int, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return map[string]int{}, nil
+	}
+	return tournament.GetColorBalance(a.currentTournament)
+}
+
+// GetColorsDue returns the color ("W", "B" or "") each player is due next round, by player ID.
+func (a *App) GetColorsDue() (map[string]string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return map[string]string{}, nil
+	}
+	return tournament.GetColorsDue(a.currentTournament)
+}
+
+// SetRoundDuration sets the time allowed per round, in minutes (0 disables the round clock).
+func (a *App) SetRoundDuration(minutes int) (bool, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return false, nil
+	}
+	if err := tournament.SetRoundDuration(a.currentTournament, minutes); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// SetPointsSystem sets the points for a win, draw and loss (e.g. 3/1/0) and rescores the tournament.
+func (a *App) SetPointsSystem(win float64, draw float64, loss float64) (bool, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return false, nil
+	}
+	if err := tournament.SetPointsSystem(a.currentTournament, win, draw, loss); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// GetBracket returns the knockout bracket as one list of matches per round.
+func (a *App) GetBracket() ([][]model.Match, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return [][]model.Match{}, nil
+	}
+	return tournament.GetBracket(a.currentTournament)
+}
+
+// GetFloaters returns the IDs of players who floated up or down in the given round.
+func (a *App) GetFloaters(roundNumber int) ([]string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return []string{}, nil
+	}
+	return tournament.GetFloaters(a.currentTournament, roundNumber)
+}
+
+// ValidateRound returns warnings about rule violations in the pairings of a round.
+func (a *App) ValidateRound(roundNumber int) ([]string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return []string{}, nil
+	}
+	return tournament.ValidateRound(a.currentTournament, roundNumber)
+}
+
+// SetTieBreakOrder sets the tie-break priority used by the standings.
+func (a *App) SetTieBreakOrder(order []string) (bool, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return false, nil
+	}
+	if err := tournament.SetTieBreakOrder(a.currentTournament, order); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// AddForbiddenPair keeps two players from being paired against each other.
+func (a *App) AddForbiddenPair(playerA string, playerB string) (bool, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return false, nil
+	}
+	if err := tournament.AddForbiddenPair(a.currentTournament, playerA, playerB); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// RemoveForbiddenPair allows two players to be paired again.
+func (a *App) RemoveForbiddenPair(playerA string, playerB string) (bool, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return false, nil
+	}
+	if err := tournament.RemoveForbiddenPair(a.currentTournament, playerA, playerB); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// WithdrawPlayer withdraws a player from the current tournament; a pending game in the
+// current round is awarded to the opponent by forfeit.
+func (a *App) WithdrawPlayer(playerID string) (bool, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return false, nil
+	}
+	if err := tournament.WithdrawPlayer(a.currentTournament, playerID); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// AddLatePlayer enters a new player into the current tournament after it has started,
+// optionally with half-point byes for the rounds already played. Returns the player ID.
+func (a *App) AddLatePlayer(name string, club string, awardByesForMissedRounds bool) (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return "", nil
+	}
+	return tournament.AddLatePlayer(a.currentTournament, strings.TrimSpace(name), strings.TrimSpace(club), awardByesForMissedRounds)
+}
+
+// GetRatingChanges returns the Elo changes of the current tournament's rated players.
+func (a *App) GetRatingChanges() ([]tournament.RatingChange, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return []tournament.RatingChange{}, nil
+	}
+	return tournament.GetRatingChanges(a.currentTournament)
+}
+
+// SetKFactors configures the Elo K-factors of the current tournament.
+func (a *App) SetKFactors(provisionalGames int, kProvisional int, kEstablished int) (bool, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return false, nil
+	}
+	if err := tournament.SetKFactors(a.currentTournament, provisionalGames, kProvisional, kEstablished); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ApplyRatingChanges writes the new ratings of a complete tournament to the players table and
+// adds the games played over the board to each player's GamesPlayed. It can only run once.
+func (a *App) ApplyRatingChanges() (bool, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil || a.db == nil {
+		return false, nil
+	}
+	if err := tournament.EnsureRatingsApplicable(a.currentTournament); err != nil {
+		return false, err
+	}
+	changes, err := tournament.GetRatingChanges(a.currentTournament)
+	if err != nil {
+		return false, err
+	}
+	games, err := tournament.PlayedGames(a.currentTournament)
+	if err != nil {
+		return false, err
+	}
+	ratings := make(map[string]int, len(changes))
+	for _, c := range changes {
+		ratings[c.PlayerID] = c.RatingAfter
+	}
+
+	tx := a.db.Begin()
+	if tx.Error != nil {
+		return false, fmt.Errorf("failed to begin transaction: %v", tx.Error)
+	}
+	for id, n := range games {
+		var stored model.Player
+		if err := tx.First(&stored, "id = ?", id).Error; err != nil {
+			tx.Rollback()
+			return false, fmt.Errorf("failed to load player %s: %v", id, err)
+		}
+		updates := map[string]interface{}{"games_played": stored.GamesPlayed + n}
+		if rating, ok := ratings[id]; ok {
+			updates["rating"] = rating
+		}
+		if err := tx.Model(&model.Player{}).Where("id = ?", id).Updates(updates).Error; err != nil {
+			tx.Rollback()
+			return false, fmt.Errorf("failed to update player %s: %v", id, err)
+		}
+	}
+	if err := tx.Commit().Error; err != nil {
+		return false, fmt.Errorf("failed to commit rating changes: %v", err)
+	}
+
+	if err := tournament.RecordRatingsApplied(a.currentTournament, changes); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ScheduleTiebreak adds an armageddon game between two players tied for a podium place.
+func (a *App) ScheduleTiebreak(playerA string, playerB string) (bool, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return false, nil
+	}
+	if err := tournament.ScheduleTiebreakMatch(a.currentTournament, playerA, playerB); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// RecordTiebreakResult records the result of tiebreak match number game (1-based).
+func (a *App) RecordTiebreakResult(game int, result model.MatchResult) (bool, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return false, nil
+	}
+	if err := tournament.RecordTiebreakResult(a.currentTournament, game, result); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// RepairTournament rebuilds the current tournament's derived state from its rounds and
+// returns the repairs made (empty when everything was consistent).
+func (a *App) RepairTournament() ([]string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return []string{}, nil
+	}
+	return tournament.RepairTournament(a.currentTournament)
+}
+
+// GetPerformanceRating returns a player's performance rating in the current tournament
+// (0 when they have no game against a rated opponent).
+func (a *App) GetPerformanceRating(playerID string) (int, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return 0, nil
+	}
+	return tournament.ComputePerformanceRating(a.currentTournament, playerID)
+}
+
+// CheckIntegrity lists the current tournament's consistency problems without changing it,
+// so the UI can show a warning (empty when healthy).
+func (a *App) CheckIntegrity() ([]string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return []string{}, nil
+	}
+	return tournament.CheckIntegrity(a.currentTournament)
+}
+
+// UpdateTournamentInfo renames the current tournament and changes its description.
+func (a *App) UpdateTournamentInfo(title string, description string) (bool, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return false, nil
+	}
+	if err := tournament.UpdateTournamentInfo(a.currentTournament, title, description); err != nil {
+		return false, err
+	}
+	if a.db != nil {
+		if err := a.db.Model(&model.Tournament{}).Where("id = ?", a.currentTournament.ID).Updates(map[string]interface{}{
+			"title":       a.currentTournament.Title,
+			"description": a.currentTournament.Description,
+		}).Error; err != nil {
+			return false, fmt.Errorf("failed to update tournament in database: %v", err)
+		}
+	}
+	return true, nil
+}
+
+// resolveEngine looks up the pairing engine registered for the current tournament's pairing
+// system, falling back to Swiss when there is no tournament or the system is unknown.
+func (a *App) resolveEngine() {
+	a.engine = tournament.SwissToolAdapter{}
+	if a.currentTournament == nil {
+		return
+	}
+	engine, err := tournament.PairingEngineFor(a.currentTournament.PairingSystem)
+	if err != nil {
+		log.Printf("%v, using Swiss pairings", err)
+		return
+	}
+	a.engine = engine
+}
+
+// SetPairingSystem chooses the event format before round 1: "SWISS", "ROUND_ROBIN",
+// "KNOCKOUT" or "TEAM". The matching pairing engine is used from then on.
+func (a *App) SetPairingSystem(system string) (bool, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return false, nil
+	}
+	engine, err := tournament.PairingEngineFor(system)
+	if err != nil {
+		return false, err
+	}
+	if err := tournament.SetPairingSystem(a.currentTournament, system); err != nil {
+		return false, err
+	}
+	a.engine = engine
+	return true, nil
+}
+
+// SetByePolicy sets who receives the pairing bye: "LOWEST", "HIGHEST" or "RANDOM".
+func (a *App) SetByePolicy(policy string) (bool, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return false, nil
+	}
+	if err := tournament.SetByePolicy(a.currentTournament, policy); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// SetFirstRoundTopColor sets the higher seed's color in round 1: "WHITE", "BLACK", "ALTERNATE"
+// or "" for the pairing method's own colors.
+func (a *App) SetFirstRoundTopColor(color string) (bool, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return false, nil
+	}
+	if err := tournament.SetFirstRoundTopColor(a.currentTournament, color); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// SetRematchWindow allows rematches between players who have not met in the last rounds rounds (0 = never).
+func (a *App) SetRematchWindow(rounds int) (bool, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return false, nil
+	}
+	if err := tournament.SetRematchWindow(a.currentTournament, rounds); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// SetMaxPlayers sets the soft maximum number of players (0 = no limit); round 1 of a larger
+// field is paired with a warning.
+func (a *App) SetMaxPlayers(maxPlayers int) (bool, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return false, nil
+	}
+	if err := tournament.SetMaxPlayers(a.currentTournament, maxPlayers); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// SetByeByRating makes the pairing bye go to the lowest rated eligible player instead of following the bye policy.
+func (a *App) SetByeByRating(enabled bool) (bool, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return false, nil
+	}
+	a.currentTournament.ByeByRating = enabled
+	return true, nil
+}
+
+// SetAllowDuplicateNames lets two players of the tournament share a name (off by default).
+func (a *App) SetAllowDuplicateNames(enabled bool) (bool, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return false, nil
+	}
+	a.currentTournament.AllowDuplicateNames = enabled
+	return true, nil
+}
+
+// SetCountForfeitsInTiebreaks chooses whether forfeited games feed Buchholz and Sonneborn-Berger
+// (on by default); off follows the strict FIDE rules.
+func (a *App) SetCountForfeitsInTiebreaks(enabled bool) (bool, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return false, nil
+	}
+	a.currentTournament.CountForfeitsInTiebreaks = enabled
+	if err := tournament.UpdateStandings(a.currentTournament); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// SetUseFIDEBuchholz switches Buchholz between the plain sum and the FIDE virtual-opponent method.
+func (a *App) SetUseFIDEBuchholz(enabled bool) (bool, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return false, nil
+	}
+	a.currentTournament.UseFIDEBuchholz = enabled
+	if err := tournament.UpdateStandings(a.currentTournament); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ListPlayers returns all players (peserta) from the database for selection in the frontend.
+func (a *App) ListPlayers() ([]model.Player, error) {
+	if a.db == nil {
+		return []model.Player{}, nil
+	}
+	var players []model.Player
+	if err := a.db.Find(&players).Error; err != nil {
+		return []model.Player{}, err
+	}
+	return players, nil
+}
+
+// SearchPlayers returns players whose name contains query (case-insensitive), optionally
+// restricted to a club, ordered by name. A limit <= 0 returns all matches from offset.
+func (a *App) SearchPlayers(query string, club string, limit int, offset int) ([]model.Player, error) {
+	if a.db == nil {
+		return []model.Player{}, nil
+	}
+
+	q := a.db.Model(&model.Player{})
+	if query = strings.TrimSpace(query); query != "" {
+		q = q.Where("LOWER(name) LIKE ?", "%"+strings.ToLower(query)+"%")
+	}
+	if club = strings.TrimSpace(club); club != "" {
+		q = q.Where("LOWER(club) = ?", strings.ToLower(club))
+	}
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+	if offset > 0 {
+		q = q.Offset(offset)
+	}
+
+	var players []model.Player
+	if err := q.Order("name ASC").Find(&players).Error; err != nil {
+		return []model.Player{}, err
+	}
+	return players, nil
+}
+
+// ListTournaments returns a summary of the stored tournaments, most recent first.
+// Archived tournaments are only included when includeArchived is set.
+// Only the metadata columns are loaded; players, rounds and events are not.
+func (a *App) ListTournaments(includeArchived bool) ([]model.TournamentSummary, error) {
+	if a.db == nil {
+		return []model.TournamentSummary{}, nil
+	}
+	query := a.db.Model(&model.Tournament{}).
+		Select("id", "title", "status", "total_players", "current_round", "start_time", "end_time", "archived")
+	if !includeArchived {
+		query = query.Where("archived = ?", false)
+	}
+	var summaries []model.TournamentSummary
+	if err := query.Order("start_time DESC").Find(&summaries).Error; err != nil {
+		return []model.TournamentSummary{}, err
+	}
+	return summaries, nil
+}
+
+// ArchiveTournament hides a stored tournament from ListTournaments; nothing else changes.
+func (a *App) ArchiveTournament(id string) (bool, error) {
+	return a.setTournamentArchived(id, true)
+}
+
+// UnarchiveTournament lists an archived tournament again.
+func (a *App) UnarchiveTournament(id string) (bool, error) {
+	return a.setTournamentArchived(id, false)
+}
+
+// setTournamentArchived stores the archived flag of a tournament, keeping the loaded one in step.
+func (a *App) setTournamentArchived(id string, archived bool) (bool, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.db == nil {
+		return false, nil
+	}
+	result := a.db.Model(&model.Tournament{}).Where("id = ?", id).Update("archived", archived)
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to update tournament in database: %v", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return false, fmt.Errorf("tournament not found: %s", id)
+	}
+	if a.currentTournament != nil && a.currentTournament.ID.String() == id {
+		a.currentTournament.Archived = archived
+	}
+	return true, nil
+}
+
+// Initialize a new tournament using selected existing player IDs.
+// No player creation; we load players from the DB and initialize the tournament.
+func (a *App) InitTournamentWithPlayerIDs(title string, description string, playerIDs []string) (bool, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.db == nil {
+		return false, nil
+	}
+	// Drop duplicate IDs, keeping the first occurrence
+	seen := make(map[string]bool, len(playerIDs))
+	uniqueIDs := make([]string, 0, len(playerIDs))
+	for _, id := range playerIDs {
+		if !seen[id] {
+			seen[id] = true
+			uniqueIDs = append(uniqueIDs, id)
+		}
+	}
+	if len(uniqueIDs) < 2 {
+		return false, fmt.Errorf("at least 2 distinct players must be selected, got %d", len(uniqueIDs))
+	}
+
+	var players []model.Player
+	if err := a.db.Where("id IN ?", uniqueIDs).Find(&players).Error; err != nil {
+		return false, err
+	}
+	if len(players) != len(uniqueIDs) {
+		found := make(map[string]bool, len(players))
+		for _, p := range players {
+			found[p.ID] = true
+		}
+		var missing []string
+		for _, id := range uniqueIDs {
+			if !found[id] {
+				missing = append(missing, id)
+			}
+		}
+		return false, fmt.Errorf("players not found: %s", strings.Join(missing, ", "))
+	}
+
+	t := &model.Tournament{
+		ByeScore:      1.0,
+		PairingSystem: "SWISS",
+	}
+	if err := tournament.InitializeTournament(t, title, description, players); err != nil {
+		return false, err
+	}
+	a.currentTournament = t
+	a.resolveEngine()
+	return true, nil
+}
+
+// NewPlayerInput is a walk-in player registered while creating a tournament.
+type NewPlayerInput struct {
+	Name   string `json:"name"`
+	Club   string `json:"club"`
+	Rating int    `json:"rating"`
+}
+
+// InitTournamentMixed creates a tournament from existing players plus walk-ins in one step.
+// The walk-ins are saved to the players table (in one transaction) only once the tournament
+// itself is valid, then everyone is entered into the new tournament.
+func (a *App) InitTournamentMixed(title string, description string, existingIDs []string, newPlayers []NewPlayerInput) (bool, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.db == nil {
+		return false, nil
+	}
+	// Drop duplicate IDs, keeping the first occurrence
+	seen := make(map[string]bool, len(existingIDs))
+	uniqueIDs := make([]string, 0, len(existingIDs))
+	for _, id := range existingIDs {
+		if !seen[id] {
+			seen[id] = true
+			uniqueIDs = append(uniqueIDs, id)
+		}
+	}
+	if len(uniqueIDs)+len(newPlayers) < 2 {
+		return false, fmt.Errorf("at least 2 distinct players must be selected, got %d", len(uniqueIDs)+len(newPlayers))
+	}
+
+	created := make([]model.Player, 0, len(newPlayers))
+	for i, input := range newPlayers {
+		name := strings.TrimSpace(input.Name)
+		if name == "" {
+			return false, fmt.Errorf("new player %d: player name is required", i+1)
+		}
+		if err := tournament.ValidateRating(input.Rating); err != nil {
+			return false, fmt.Errorf("new player %s: %w", name, err)
+		}
+		created = append(created, model.Player{
+			ID:           uuid.NewString(),
+			Name:         name,
+			OpponentIDs:  []string{},
+			ColorHistory: "",
+			Club:         strings.TrimSpace(input.Club),
+			Rating:       input.Rating,
+		})
+	}
+
+	var players []model.Player
+	if len(uniqueIDs) > 0 {
+		if err := a.db.Where("id IN ?", uniqueIDs).Find(&players).Error; err != nil {
+			return false, err
+		}
+	}
+	if len(players) != len(uniqueIDs) {
+		found := make(map[string]bool, len(players))
+		for _, p := range players {
+			found[p.ID] = true
+		}
+		var missing []string
+		for _, id := range uniqueIDs {
+			if !found[id] {
+				missing = append(missing, id)
+			}
+		}
+		return false, fmt.Errorf("players not found: %s", strings.Join(missing, ", "))
+	}
+
+	t := &model.Tournament{
+		ByeScore:      1.0,
+		PairingSystem: "SWISS",
+	}
+	if err := tournament.InitializeTournament(t, title, description, append(players, created...)); err != nil {
+		return false, err
+	}
+
+	if len(created) > 0 {
+		// One transaction so a failed save leaves no partial roster behind
+		tx := a.db.Begin()
+		if tx.Error != nil {
+			return false, fmt.Errorf("failed to begin transaction: %v", tx.Error)
+		}
+		if err := tx.Create(&created).Error; err != nil {
+			tx.Rollback()
+			return false, fmt.Errorf("failed to save players to database: %v", err)
+		}
+		if err := tx.Commit().Error; err != nil {
+			return false, fmt.Errorf("failed to commit transaction: %v", err)
+		}
+	}
+
+	a.currentTournament = t
+	a.resolveEngine()
+	return true, nil
+}
+
+// CancelCurrentRound cancels the current round and reverts to the previous round state.
+// Requires SUDO. Returns true if the round was successfully cancelled.
+func (a *App) CancelCurrentRound(username string) (bool, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return false, nil
+	}
+	if err := a.requireRole(username, model.Sudo); err != nil {
+		return false, err
+	}
+	if err := tournament.CancelCurrentRound(a.currentTournament); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// RestoreRound brings a cancelled round back as the current round. Requires SUDO.
+func (a *App) RestoreRound(username string, roundNumber int) (bool, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return false, nil
+	}
+	if err := a.requireRole(username, model.Sudo); err != nil {
+		return false, err
+	}
+	if err := tournament.RestoreRound(a.currentTournament, roundNumber); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ReopenTournament sets a completed tournament back to ACTIVE so results can be corrected. Requires SUDO.
+func (a *App) ReopenTournament(username string) (bool, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return false, nil
+	}
+	if err := a.requireRole(username, model.Sudo); err != nil {
+		return false, err
+	}
+	if err := tournament.ReopenTournament(a.currentTournament); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ResetTournament clears all rounds, results and events while keeping the roster, so the event
+// can start again from round 0. Once a round has been paired it requires SUDO; a complete
+// tournament is only reset with force.
+func (a *App) ResetTournament(username string, force bool) (bool, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return false, nil
+	}
+	if a.currentTournament.CurrentRound > 0 {
+		if err := a.requireRole(username, model.Sudo); err != nil {
+			return false, err
+		}
+	}
+	if err := tournament.ResetTournament(a.currentTournament, force); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ExportRoundPairingsToPDF exports the pairings for a specific round to PDF.
+// Returns the PDF data as bytes.
+func (a *App) ExportRoundPairingsToPDF(roundNumber int) ([]byte, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return nil, nil
+	}
+	return tournament.ExportRoundPairingsToPDF(a.currentTournament, roundNumber)
+}
+
+// ExportRoundPairingsToPDFWithColors exports round pairings to PDF with each player's color
+// history (e.g. "WBW") next to their name.
+func (a *App) ExportRoundPairingsToPDFWithColors(roundNumber int) ([]byte, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return nil, nil
+	}
+	return tournament.ExportRoundPairingsToPDFWithOptions(a.currentTournament, roundNumber, tournament.PairingsPDFOptions{
+		IncludeColorHistory: true,
+	})
+}
+
+// ExportScoreSheetPDF exports the round pairings with an empty Result box per game for
+// writing the results by hand.
+func (a *App) ExportScoreSheetPDF(roundNumber int) ([]byte, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return nil, nil
+	}
+	return tournament.ExportScoreSheetPDF(a.currentTournament, roundNumber)
+}
+
+// SaveScoreSheetPDF exports the hand-scoring sheet of a round and saves it to Desktop.
+// Returns the file path where the PDF was saved.
+func (a *App) SaveScoreSheetPDF(roundNumber int) (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return "", fmt.Errorf("no active tournament")
+	}
+
+	pdfBytes, err := tournament.ExportScoreSheetPDF(a.currentTournament, roundNumber)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate PDF: %w", err)
+	}
+
+	fileName := fmt.Sprintf("Lembar_Hasil_Ronde_%d_%s.pdf", roundNumber, fileSafeTitle(a.currentTournament.Title))
+	return saveToDesktop(fileName, pdfBytes)
+}
+
+// fileSafeTitle turns a tournament title into a file name part: spaces become underscores and
+// characters not allowed in file names on Windows, macOS or Linux are dropped.
+func fileSafeTitle(title string) string {
+	name := strings.Map(func(r rune) rune {
+		switch {
+		case r < 32 || strings.ContainsRune(`<>:"/\|?*`, r):
+			return -1
+		case unicode.IsSpace(r):
+			return '_'
+		}
+		return r
+	}, strings.TrimSpace(title))
+	// Windows refuses names ending in a dot
+	name = strings.TrimRight(name, ".")
+	if name == "" {
+		return "Turnamen"
+	}
+	return name
+}
+
+// saveToDesktop writes data to fileName in the user's Desktop directory and returns the path.
+func saveToDesktop(fileName string, data []byte) (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+	filePath := filepath.Join(homeDir, "Desktop", fileName)
+	if err := os.WriteFile(filePath, data, 0644); err != nil {
+		return "", fmt.Errorf("failed to save %s: %w", fileName, err)
+	}
+	return filePath, nil
+}
+
+// SaveRoundPairingsToPDF exports round pairings to PDF and saves to Desktop.
+// Returns the file path where the PDF was saved.
+func (a *App) SaveRoundPairingsToPDF(roundNumber int) (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return "", fmt.Errorf("no active tournament")
+	}
+	
+	// Generate PDF bytes
+	pdfBytes, err := tournament.ExportRoundPairingsToPDF(a.currentTournament, roundNumber)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate PDF: %w", err)
+	}
+	
+	fileName := fmt.Sprintf("Ronde_%d_%s.pdf", roundNumber, fileSafeTitle(a.currentTournament.Title))
+	return saveToDesktop(fileName, pdfBytes)
+}
+
+// ExportAllRoundsPairingsToPDF exports all rounds pairings to a single PDF.
+// Returns the PDF data as bytes.
+func (a *App) ExportAllRoundsPairingsToPDF() ([]byte, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return nil, nil
+	}
+	return tournament.ExportAllRoundsPairingsToPDF(a.currentTournament)
+}
+
+// SaveAllRoundsPairingsToPDF exports all rounds pairings to PDF and saves to Desktop.
+// Returns the file path where the PDF was saved.
+func (a *App) SaveAllRoundsPairingsToPDF() (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return "", fmt.Errorf("no active tournament")
+	}
+	
+	// Generate PDF bytes
+	pdfBytes, err := tournament.ExportAllRoundsPairingsToPDF(a.currentTournament)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate PDF: %w", err)
+	}
+	
+	fileName := fmt.Sprintf("Semua_Ronde_%s.pdf", fileSafeTitle(a.currentTournament.Title))
+	return saveToDesktop(fileName, pdfBytes)
+}
+
+// ExportStandingsToPDF exports the tournament standings to PDF.
+// Returns the PDF data as bytes.
+func (a *App) ExportStandingsToPDF() ([]byte, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return nil, nil
+	}
+	return tournament.ExportStandingsToPDF(a.currentTournament)
+}
+
+// SaveStandingsToPDF exports tournament standings to PDF and saves to Desktop.
+// Returns the file path where the PDF was saved.
+func (a *App) SaveStandingsToPDF() (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return "", fmt.Errorf("no active tournament")
+	}
+	
+	// Generate PDF bytes
+	pdfBytes, err := tournament.ExportStandingsToPDF(a.currentTournament)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate PDF: %w", err)
+	}
+	
+	fileName := fmt.Sprintf("Klasemen_%s.pdf", fileSafeTitle(a.currentTournament.Title))
+	return saveToDesktop(fileName, pdfBytes)
+}
+
+// ExportCrosstableToPDF exports the tournament crosstable (wall chart) to PDF.
+// Returns the PDF data as bytes.
+func (a *App) ExportCrosstableToPDF() ([]byte, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return nil, nil
+	}
+	return tournament.ExportCrosstableToPDF(a.currentTournament)
+}
+
+// SaveCrosstableToPDF exports the tournament crosstable to PDF and saves to Desktop.
+// Returns the file path where the PDF was saved.
+func (a *App) SaveCrosstableToPDF() (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return "", fmt.Errorf("no active tournament")
+	}
+
+	// Generate PDF bytes
+	pdfBytes, err := tournament.ExportCrosstableToPDF(a.currentTournament)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate PDF: %w", err)
+	}
+
+	fileName := fmt.Sprintf("Tabel_Silang_%s.pdf", fileSafeTitle(a.currentTournament.Title))
+	return saveToDesktop(fileName, pdfBytes)
+}
+
+// ExportPlayerSlipsToPDF exports one pairing card per player of a round to PDF.
+// Returns the PDF data as bytes.
+func (a *App) ExportPlayerSlipsToPDF(roundNumber int) ([]byte, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return nil, nil
+	}
+	return tournament.ExportPlayerSlipsToPDF(a.currentTournament, roundNumber)
+}
+
+// SavePlayerSlipsToPDF exports the player pairing cards of a round to PDF and saves to Desktop.
+// Returns the file path where the PDF was saved.
+func (a *App) SavePlayerSlipsToPDF(roundNumber int) (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return "", fmt.Errorf("no active tournament")
+	}
+
+	// Generate PDF bytes
+	pdfBytes, err := tournament.ExportPlayerSlipsToPDF(a.currentTournament, roundNumber)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate PDF: %w", err)
+	}
+
+	fileName := fmt.Sprintf("Kartu_Ronde_%d_%s.pdf", roundNumber, fileSafeTitle(a.currentTournament.Title))
+	return saveToDesktop(fileName, pdfBytes)
+}
+
+// ExportAlphabeticalPairingsToPDF exports the pairings of a round listed by player name to PDF.
+// Returns the PDF data as bytes.
+func (a *App) ExportAlphabeticalPairingsToPDF(roundNumber int) ([]byte, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return nil, nil
+	}
+	return tournament.ExportAlphabeticalPairingsToPDF(a.currentTournament, roundNumber)
+}
+
+// SaveAlphabeticalPairingsToPDF exports the pairings of a round listed by player name to PDF
+// and saves to Desktop. Returns the file path where the PDF was saved.
+func (a *App) SaveAlphabeticalPairingsToPDF(roundNumber int) (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return "", fmt.Errorf("no active tournament")
+	}
+
+	// Generate PDF bytes
+	pdfBytes, err := tournament.ExportAlphabeticalPairingsToPDF(a.currentTournament, roundNumber)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate PDF: %w", err)
+	}
+
+	fileName := fmt.Sprintf("Ronde_%d_Abjad_%s.pdf", roundNumber, fileSafeTitle(a.currentTournament.Title))
+	return saveToDesktop(fileName, pdfBytes)
+}
+
+// ExportTRF exports the tournament in FIDE TRF format.
+// Returns the report data as bytes.
+func (a *App) ExportTRF() ([]byte, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return nil, nil
+	}
+	return tournament.ExportTRF(a.currentTournament)
+}
+
+// SaveTRF exports the tournament in FIDE TRF format and saves to Desktop.
+// Returns the file path where the report was saved.
+func (a *App) SaveTRF() (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return "", fmt.Errorf("no active tournament")
+	}
+
+	// Generate TRF bytes
+	trfBytes, err := tournament.ExportTRF(a.currentTournament)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate TRF: %w", err)
+	}
+
+	fileName := fmt.Sprintf("%s.trf", fileSafeTitle(a.currentTournament.Title))
+	return saveToDesktop(fileName, trfBytes)
+}
+
+// SaveAuditLog exports the tournament's event log as "json" or "csv" and saves to Desktop.
+// Returns the file path where the log was saved.
+func (a *App) SaveAuditLog(format string) (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return "", fmt.Errorf("no active tournament")
+	}
+
+	// Generate the log in the requested format
+	format = strings.ToLower(strings.TrimSpace(format))
+	var logBytes []byte
+	var err error
+	switch format {
+	case "json":
+		logBytes, err = tournament.ExportEventsToJSON(a.currentTournament)
+	case "csv":
+		logBytes, err = tournament.ExportEventsToCSV(a.currentTournament)
+	default:
+		return "", fmt.Errorf("unsupported audit log format: %s", format)
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to generate audit log: %w", err)
+	}
+
+	fileName := fmt.Sprintf("Log_Audit_%s.%s", fileSafeTitle(a.currentTournament.Title), format)
+	return saveToDesktop(fileName, logBytes)
+}
+
+// AddPlayer adds a new player to the database and optionally to the current tournament.
+// Returns the player ID if successful.
+func (a *App) AddPlayer(name string, club string) (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	// Validate required fields
+	if strings.TrimSpace(name) == "" {
+		return "", fmt.Errorf("player name is required")
+	}
+
+	log.Printf("AddPlayer called: name='%s', club='%s'", name, club)
+
+	// Generate new UUID for the player
+	playerID := uuid.NewString()
+
+	// Create new player
+	newPlayer := model.Player{
+		ID:           playerID,
+		Name:         strings.TrimSpace(name),
+		Score:        0.0,
+		OpponentIDs:  []string{},
+		Buchholz:     0.0,
+		ColorHistory: "",
+		HasBye:       false,
+		Club:         strings.TrimSpace(club),
+	}
+
+	// Save to database with transaction for better reliability
+	if a.db != nil {
+		// Use transaction to ensure atomicity
+		tx := a.db.Begin()
+		if tx.Error != nil {
+			log.Printf("Failed to begin transaction: %v", tx.Error)
+			return "", fmt.Errorf("failed to begin transaction: %v", tx.Error)
+		}
+
+		if err := tx.Create(&newPlayer).Error; err != nil {
+			tx.Rollback()
+			log.Printf("Failed to save player to database: %v", err)
+			return "", fmt.Errorf("failed to save player to database: %v", err)
+		}
+
+		// Force commit and sync to disk (important for Windows)
+		if err := tx.Commit().Error; err != nil {
+			log.Printf("Failed to commit transaction: %v", err)
+			return "", fmt.Errorf("failed to commit transaction: %v", err)
+		}
+
+		// Additional sync for Windows - force write to disk
+		if err := a.db.Exec("PRAGMA synchronous = FULL").Error; err != nil {
+			log.Printf("Warning: Failed to set synchronous mode: %v", err)
+		}
+
+		log.Printf("Player saved to database successfully: ID=%s", playerID)
+	} else {
+		log.Printf("Warning: Database is nil, player not saved to database")
+	}
+
+	// If there's an active tournament and it hasn't started, add player to tournament
+	if a.currentTournament != nil {
+		log.Printf("Current tournament exists, CurrentRound=%d", a.currentTournament.CurrentRound)
+		if a.currentTournament.CurrentRound == 0 {
+			if _, err := tournament.AddPlayer(a.currentTournament, name, club); err != nil {
+				// Player saved to DB but failed to add to tournament - that's okay
+				log.Printf("Player saved to DB but failed to add to tournament: %v", err)
+			} else {
+				log.Printf("Player added to tournament successfully")
+			}
+		} else {
+			log.Printf("Tournament has already started (round %d), player not added to tournament", a.currentTournament.CurrentRound)
+		}
+	} else {
+		log.Printf("No active tournament, player only saved to database")
+	}
+
+	return playerID, nil
+}
+
+// ImportPlayersFromCSV bulk-loads players from a CSV file with the columns name, club, rating
+// (an optional header row is skipped). Names already in the database, or repeated in the file,
+// are skipped. Rating must be a non-negative integer; an empty rating means unrated.
+// Returns the number of players inserted.
+func (a *App) ImportPlayersFromCSV(path string) (int, error) {
+	if a.db == nil {
+		return 0, nil
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, fmt.Errorf("failed to open CSV file: %w", err)
+	}
+	defer f.Close()
+
+	reader := csv.NewReader(f)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	// Existing names, so exact duplicates are skipped
+	var existing []string
+	if err := a.db.Model(&model.Player{}).Pluck("name", &existing).Error; err != nil {
+		return 0, err
+	}
+	seen := make(map[string]bool, len(existing))
+	for _, name := range existing {
+		seen[name] = true
+	}
+
+	var players []model.Player
+	for first := true; ; first = false {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return 0, fmt.Errorf("failed to read CSV: %w", err)
+		}
+		line, _ := reader.FieldPos(0)
+
+		if first && strings.EqualFold(strings.TrimSpace(record[0]), "name") {
+			continue
+		}
+		if len(record) != 3 {
+			return 0, fmt.Errorf("line %d: expected 3 columns (name, club, rating), got %d", line, len(record))
+		}
+
+		name := strings.TrimSpace(record[0])
+		club := strings.TrimSpace(record[1])
+		if name == "" {
+			return 0, fmt.Errorf("line %d: player name is required", line)
+		}
+		rating := 0
+		if value := strings.TrimSpace(record[2]); value != "" {
+			rating, err = strconv.Atoi(value)
+			if err != nil || rating < 0 {
+				return 0, fmt.Errorf("line %d: rating must be a non-negative integer, got %q", line, value)
+			}
+		}
+
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+		players = append(players, model.Player{
+			ID:           uuid.NewString(),
+			Name:         name,
+			OpponentIDs:  []string{},
+			ColorHistory: "",
+			Club:         club,
+			Rating:       rating,
+		})
+	}
+
+	if len(players) == 0 {
+		return 0, nil
+	}
+	// One transaction so a failed import leaves no partial roster behind
+	tx := a.db.Begin()
+	if tx.Error != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %v", tx.Error)
+	}
+	if err := tx.Create(&players).Error; err != nil {
+		tx.Rollback()
+		return 0, fmt.Errorf("failed to save players to database: %v", err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	log.Printf("Imported %d players from %s", len(players), path)
+	return len(players), nil
+}
+
+// UpdatePlayer corrects a player's name and club in the database and, if the player
+// takes part in the active tournament, in the tournament as well.
+func (a *App) UpdatePlayer(id string, name string, club string) (bool, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	name = strings.TrimSpace(name)
+	club = strings.TrimSpace(club)
+	if name == "" {
+		return false, fmt.Errorf("player name is required")
+	}
+
+	found := false
+	if a.db != nil {
+		result := a.db.Model(&model.Player{}).Where("id = ?", id).Updates(map[string]interface{}{
+			"name": name,
+			"club": club,
+		})
+		if result.Error != nil {
+			return false, fmt.Errorf("failed to update player in database: %v", result.Error)
+		}
+		found = result.RowsAffected > 0
+	}
+
+	if a.currentTournament != nil {
+		players, err := a.currentTournament.GetPlayers()
+		if err != nil {
+			return false, err
+		}
+		for _, p := range players {
+			if p.ID == id {
+				if err := tournament.UpdatePlayer(a.currentTournament, id, name, club); err != nil {
+					return false, err
+				}
+				found = true
+				break
+			}
+		}
+	}
+
+	if !found {
+		return false, fmt.Errorf("player not found: %s", id)
+	}
+	return true, nil
+}
+
+// SetPlayerRating sets a player's rating (0-4000, 0 = unrated) in the database and, when the
+// player is part of the current tournament, there as well.
+func (a *App) SetPlayerRating(id string, rating int) (bool, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if err := tournament.ValidateRating(rating); err != nil {
+		return false, err
+	}
+
+	found := false
+	if a.db != nil {
+		result := a.db.Model(&model.Player{}).Where("id = ?", id).Update("rating", rating)
+		if result.Error != nil {
+			return false, fmt.Errorf("failed to update player in database: %v", result.Error)
+		}
+		found = result.RowsAffected > 0
+	}
+
+	if a.currentTournament != nil {
+		players, err := a.currentTournament.GetPlayers()
+		if err != nil {
+			return false, err
+		}
+		for _, p := range players {
+			if p.ID == id {
+				if err := tournament.SetPlayerRating(a.currentTournament, id, rating); err != nil {
+					return false, err
+				}
+				found = true
+				break
+			}
+		}
+	}
+
+	if !found {
+		return false, fmt.Errorf("player not found: %s", id)
+	}
+	return true, nil
+}
+
+// DeletePlayer removes a player from the players table. Players who are part of a stored
+// tournament or of the current one are kept; the error names those tournaments.
+func (a *App) DeletePlayer(id string) (bool, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.db == nil {
+		return false, nil
+	}
+
+	var tournaments []model.Tournament
+	if err := a.db.Select("id", "title", "players").Find(&tournaments).Error; err != nil {
+		return false, fmt.Errorf("failed to load tournaments: %v", err)
+	}
+	if a.currentTournament != nil {
+		tournaments = append(tournaments, *a.currentTournament)
+	}
+
+	seen := make(map[uuid.UUID]bool, len(tournaments))
+	var referencedBy []string
+	for _, t := range tournaments {
+		if seen[t.ID] {
+			continue
+		}
+		seen[t.ID] = true
+		players, err := t.GetPlayers()
+		if err != nil {
+			return false, fmt.Errorf("failed to read players of tournament %s: %v", t.Title, err)
+		}
+		for _, p := range players {
+			if p.ID == id {
+				referencedBy = append(referencedBy, t.Title)
+				break
+			}
+		}
+	}
+	if len(referencedBy) > 0 {
+		return false, fmt.Errorf("player %s cannot be deleted: part of tournament(s) %s", id, strings.Join(referencedBy, ", "))
+	}
+
+	result := a.db.Where("id = ?", id).Delete(&model.Player{})
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to delete player: %v", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return false, fmt.Errorf("player not found: %s", id)
+	}
+	return true, nil
+}
+
+// ClearMatchResult clears the result of a specific match
+func (a *App) ClearMatchResult(roundNumber int, tableNumber int) (bool, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return false, nil
+	}
+	if err := tournament.ClearMatchResult(a.currentTournament, roundNumber, tableNumber); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ClearAllResultsInRound clears all results in a specific round. Requires SUDO.
+func (a *App) ClearAllResultsInRound(username string, roundNumber int) (bool, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return false, nil
+	}
+	if err := a.requireRole(username, model.Sudo); err != nil {
+		return false, err
+	}
+	if err := tournament.ClearAllResultsInRound(a.currentTournament, roundNumber); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// SwapResultsInRound flips A_WIN/B_WIN for the given tables of a round
+func (a *App) SwapResultsInRound(roundNumber int, tableNumbers []int) (bool, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return false, nil
+	}
+	if err := tournament.SwapResultsInRound(a.currentTournament, roundNumber, tableNumbers); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// RenumberTables moves the games of an unplayed round to other tables (old table -> new table).
+func (a *App) RenumberTables(roundNumber int, mapping map[int]int) (bool, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return false, nil
+	}
+	if err := tournament.RenumberTables(a.currentTournament, roundNumber, mapping); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// SwapColors flips White and Black of an unplayed match.
+func (a *App) SwapColors(roundNumber int, tableNumber int) (bool, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return false, nil
+	}
+	if err := tournament.SwapColors(a.currentTournament, roundNumber, tableNumber); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// UndoLastAction reverses the most recent result entry, result swap or round start.
+func (a *App) UndoLastAction() (bool, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return false, nil
+	}
+	if err := tournament.UndoLastAction(a.currentTournament); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// RedoLastAction reapplies the most recently undone action.
+func (a *App) RedoLastAction() (bool, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return false, nil
+	}
+	if err := tournament.RedoLastAction(a.currentTournament); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// RequestBye records a bye requested in advance by a player for a future round.
+func (a *App) RequestBye(playerID string, roundNumber int, value float64) (bool, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.currentTournament == nil {
+		return false, nil
+	}
+	if err := tournament.RequestBye(a.currentTournament, playerID, roundNumber, value); err != nil {
+		return false, err
 	}
 	return true, nil
 }
 
-// GoBackToPreviousRound goes back to the previous round
-func (a *App) GoBackToPreviousRound() (bool, error) {
+// GoBackToPreviousRound goes back to the previous round. Requires SUDO.
+func (a *App) GoBackToPreviousRound(username string) (bool, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
 	fmt.Printf("DEBUG: GoBackToPreviousRound called in app.go\n")
 	if a.currentTournament == nil {
 		fmt.Printf("DEBUG: No current tournament\n")
 		return false, nil
 	}
+	if err := a.requireRole(username, model.Sudo); err != nil {
+		return false, err
+	}
 	fmt.Printf("DEBUG: Current tournament exists, calling tournament.GoBackToPreviousRound\n")
 	if err := tournament.GoBackToPreviousRound(a.currentTournament); err != nil {
 		fmt.Printf("DEBUG: Error from tournament.GoBackToPreviousRound: %v\n", err)
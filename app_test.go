@@ -0,0 +1,90 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"xchess-desktop/internal/model"
+	"xchess-desktop/internal/tournament"
+)
+
+// Run with -race: concurrent RecordResult calls must not race on the current tournament.
+func TestRecordResultConcurrent(t *testing.T) {
+	tour := &model.Tournament{PairingSeed: 1}
+	players := make([]model.Player, 0, 16)
+	for i := 1; i <= 16; i++ {
+		players = append(players, model.Player{ID: fmt.Sprintf("p%d", i), Name: fmt.Sprintf("Player %d", i)})
+	}
+	if err := tournament.InitializeTournament(tour, "Race Open", "Concurrent results", players); err != nil {
+		t.Fatal(err)
+	}
+	a := &App{currentTournament: tour, engine: tournament.SwissToolAdapter{}}
+	if ok, err := a.NextRound(); !ok || err != nil {
+		t.Fatalf("NextRound = %t, %v", ok, err)
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 8)
+	for table := 1; table <= 8; table++ {
+		wg.Add(1)
+		go func(table int) {
+			defer wg.Done()
+			if _, err := a.RecordResult(table, model.ResultDraw); err != nil {
+				errs <- fmt.Errorf("table %d: %w", table, err)
+			}
+			// Readers run alongside the writers
+			if _, err := a.GetStandings(); err != nil {
+				errs <- err
+			}
+		}(table)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Error(err)
+	}
+
+	standings, err := a.GetStandings()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range standings {
+		if p.Score != 0.5 {
+			t.Errorf("%s scored %.1f, want 0.5 after a draw", p.Name, p.Score)
+		}
+	}
+	rounds, err := tour.GetRounds()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !rounds[0].IsComplete {
+		t.Error("round 1 is not complete after all eight results")
+	}
+}
+
+func TestSaveToDesktop(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("USERPROFILE", home)
+	if err := os.Mkdir(filepath.Join(home, "Desktop"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	path, err := saveToDesktop("Klasemen_Test.pdf", []byte("%PDF"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := filepath.Join(home, "Desktop", "Klasemen_Test.pdf"); path != want {
+		t.Errorf("path = %s, want %s", path, want)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "%PDF" {
+		t.Errorf("saved %q, want %q", data, "%PDF")
+	}
+}
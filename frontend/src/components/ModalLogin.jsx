@@ -42,6 +42,8 @@ function ModalLogin({ isOpen, onClose, onSuccess }) {
       .then((result) => {
         if (result === true) {
           setMessage("Login berhasil!");
+          // Remember the acting user for operations that require a role
+          sessionStorage.setItem("xchess_username", username);
           onSuccess && onSuccess(username);
           // Navigate to the create tournament page
           navigate("/create-tournament", { replace: true });
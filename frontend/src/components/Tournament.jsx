@@ -165,8 +165,8 @@ function Tournament() {
                   <td>{idToName[m.white_id] || m.white_id}</td>
                   <td>{idToName[m.black_id] || m.black_id}</td>
                   <td>
-                    {m.player_b_id === "BYE" ? (
-                      <button onClick={() => recordResult(m.table_number, "BYE_A")}>Apply Bye</button>
+                    {m.player_b_id === "BYE" || m.player_a_id === "BYE" ? (
+                      <button onClick={() => recordResult(m.table_number, m.player_a_id === "BYE" ? "BYE_B" : "BYE_A")}>Apply Bye</button>
                     ) : (
                       <>
                         <button onClick={() => recordResult(m.table_number, "A_WIN")}>A Win</button>
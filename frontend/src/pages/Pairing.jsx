@@ -82,7 +82,8 @@ function Pairing() {
       setStatus("Kembali ke ronde sebelumnya...");
       console.log("DEBUG: Calling GoBackToPreviousRound backend function");
 
-      const result = await GoBackToPreviousRound();
+      const username = sessionStorage.getItem("xchess_username") || "";
+      const result = await GoBackToPreviousRound(username);
       console.log("DEBUG: GoBackToPreviousRound result:", result);
 
       if (result) {
@@ -366,14 +367,21 @@ function Pairing() {
                             </td>
                             <td className="px-4 py-4">
                               <div className="flex justify-center space-x-2">
-                                {match.player_b_id === "BYE" ? (
+                                {match.player_b_id === "BYE" ||
+                                match.player_a_id === "BYE" ? (
                                   <button
                                     onClick={() =>
-                                      recordResult(match.table_number, "BYE_A")
+                                      recordResult(
+                                        match.table_number,
+                                        match.player_a_id === "BYE"
+                                          ? "BYE_B"
+                                          : "BYE_A"
+                                      )
                                     }
                                     className={getResultButtonStyle(
                                       "BYE_A",
-                                      match.result === "BYE_A"
+                                      match.result === "BYE_A" ||
+                                        match.result === "BYE_B"
                                     )}
                                   >
                                     Apply Bye
@@ -9,10 +9,27 @@ import (
 	"log"
 	"strings"
 
+	"github.com/google/uuid"
 	"golang.org/x/crypto/bcrypt"
 	"gorm.io/gorm"
 )
 
+// PermissionError is returned when the acting user lacks the role an operation requires
+type PermissionError struct {
+	Username string
+	Required model.Role
+}
+
+func (e *PermissionError) Error() string {
+	return fmt.Sprintf("permission denied: user %q requires role %s", e.Username, e.Required)
+}
+
+// roleRank orders roles so that a higher role includes the permissions of lower ones
+var roleRank = map[model.Role]int{
+	model.Admin: 1,
+	model.Sudo:  2,
+}
+
 // Service manages authentication operations
 type Service struct {
 	db *database.DB
@@ -68,3 +85,72 @@ func (s *Service) CheckCredentials(username, password string) (bool, error) {
 	log.Printf("auth: bcrypt compare succeeded for user=%q", username)
 	return true, nil // Credentials are valid
 }
+
+// HasRole checks if the user exists and holds at least the required role (SUDO includes ADMIN)
+func (s *Service) HasRole(username string, required model.Role) (bool, error) {
+	var admin model.Administrator
+
+	result := s.db.Where("username = ?", username).First(&admin)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return false, nil // User not found
+		}
+		return false, fmt.Errorf("database query error: %w", result.Error)
+	}
+
+	return roleRank[admin.Role] >= roleRank[required], nil
+}
+
+// RequireRole returns a *PermissionError when the user does not hold the required role
+func (s *Service) RequireRole(username string, required model.Role) error {
+	ok, err := s.HasRole(username, required)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		log.Printf("auth: permission denied for user=%q (requires %s)", username, required)
+		return &PermissionError{Username: username, Required: required}
+	}
+	return nil
+}
+
+// CreateAdmin creates a new administrator account with a bcrypt-hashed password
+func (s *Service) CreateAdmin(username, password string, role model.Role) error {
+	username = strings.TrimSpace(username)
+	if username == "" {
+		return fmt.Errorf("username is required")
+	}
+	if password == "" {
+		return fmt.Errorf("password is required")
+	}
+	if _, ok := roleRank[role]; !ok {
+		return fmt.Errorf("invalid role %q", role)
+	}
+
+	var existing model.Administrator
+	result := s.db.Where("username = ?", username).First(&existing)
+	if result.Error == nil {
+		return fmt.Errorf("administrator %q already exists", username)
+	}
+	if result.Error != gorm.ErrRecordNotFound {
+		return fmt.Errorf("database query error: %w", result.Error)
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	admin := model.Administrator{
+		ID:       uuid.New(),
+		Username: username,
+		Password: string(hashedPassword),
+		Role:     role,
+	}
+	if err := s.db.Create(&admin).Error; err != nil {
+		return fmt.Errorf("failed to create administrator: %w", err)
+	}
+
+	log.Printf("auth: administrator created: username=%q role=%s", username, role)
+	return nil
+}
@@ -0,0 +1,14 @@
+//go:build !sqlcipher
+
+package database
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// encryptedDialector is unavailable without the sqlcipher build tag.
+func encryptedDialector(dsn string, key string, journalMode string) (gorm.Dialector, error) {
+	return nil, fmt.Errorf("database encryption is not available in this build (build with -tags \"sqlcipher libsqlite3\")")
+}
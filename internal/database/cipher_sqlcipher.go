@@ -0,0 +1,68 @@
+//go:build sqlcipher
+
+// Encrypted databases need go-sqlite3 linked against SQLCipher instead of its bundled SQLite:
+// build with -tags "sqlcipher libsqlite3" and point CGO_CFLAGS/CGO_LDFLAGS at a SQLCipher
+// build of libsqlite3.
+
+package database
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"strings"
+	"sync/atomic"
+
+	"github.com/mattn/go-sqlite3"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// encryptedDrivers numbers the drivers registered by encryptedDialector; database/sql does not
+// allow registering a name twice and every driver carries its own key.
+var encryptedDrivers int64
+
+// encryptedDialector returns a GORM dialector whose connections are unlocked with key before
+// anything else touches the file. A wrong key (or a plaintext database) fails when the
+// connection is opened, with an error instead of a panic.
+func encryptedDialector(dsn string, key string, journalMode string) (gorm.Dialector, error) {
+	name := fmt.Sprintf("sqlite3_sqlcipher_%d", atomic.AddInt64(&encryptedDrivers, 1))
+	sql.Register(name, &sqlite3.SQLiteDriver{
+		ConnectHook: func(conn *sqlite3.SQLiteConn) error {
+			if err := ensureSQLCipher(conn); err != nil {
+				return err
+			}
+			// SQLCipher derives the encryption key from the passphrase (PBKDF2)
+			if _, err := conn.Exec(fmt.Sprintf("PRAGMA key = '%s';", strings.ReplaceAll(key, "'", "''")), nil); err != nil {
+				return fmt.Errorf("failed to set database key: %v", err)
+			}
+			// A wrong key only shows once the first page is read
+			if _, err := conn.Exec("SELECT count(*) FROM sqlite_master;", nil); err != nil {
+				return fmt.Errorf("cannot unlock the database: wrong encryption key or not an encrypted database")
+			}
+			if _, err := conn.Exec(fmt.Sprintf("PRAGMA journal_mode = %s;", journalMode), nil); err != nil {
+				return fmt.Errorf("failed to set journal mode: %v", err)
+			}
+			return nil
+		},
+	})
+	return sqlite.New(sqlite.Config{DriverName: name, DSN: dsn}), nil
+}
+
+// ensureSQLCipher checks that the linked SQLite library is SQLCipher, so a misbuilt binary
+// never writes a plaintext database while the user believes it is encrypted.
+func ensureSQLCipher(conn *sqlite3.SQLiteConn) error {
+	rows, err := conn.Query("PRAGMA cipher_version;", nil)
+	if err != nil {
+		return fmt.Errorf("failed to query SQLCipher version: %v", err)
+	}
+	defer rows.Close()
+	values := make([]driver.Value, len(rows.Columns()))
+	if err := rows.Next(values); err == io.EOF {
+		return fmt.Errorf("database encryption is not available: SQLite is not linked against SQLCipher")
+	} else if err != nil {
+		return fmt.Errorf("failed to query SQLCipher version: %v", err)
+	}
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
@@ -48,18 +49,111 @@ func GetDBPath() (string, error) {
 	return dbPath, nil
 }
 
-// New creates a standard unencrypted database
-func New(dbPath string) (*DB, error) {
+// EncryptionKeyEnv is the environment variable holding the database passphrase when New is
+// not given WithEncryptionKey. Without a passphrase the database is not encrypted.
+const EncryptionKeyEnv = "XCHESS_DB_KEY"
+
+// options holds the SQLite connection settings passed in the DSN.
+type options struct {
+	journalMode   string
+	synchronous   string
+	busyTimeoutMS int
+	encryptionKey string
+}
+
+// journalModes and synchronousLevels are the values SQLite accepts; the chosen ones end up in
+// the DSN and in a PRAGMA statement, so nothing else may pass.
+var (
+	journalModes      = map[string]bool{"WAL": true, "DELETE": true, "TRUNCATE": true, "PERSIST": true, "MEMORY": true, "OFF": true}
+	synchronousLevels = map[string]bool{"OFF": true, "NORMAL": true, "FULL": true, "EXTRA": true}
+)
+
+// validate normalizes the journal mode and synchronous level to upper case and rejects
+// values SQLite does not know, and a negative busy timeout.
+func (o *options) validate() error {
+	o.journalMode = strings.ToUpper(strings.TrimSpace(o.journalMode))
+	if !journalModes[o.journalMode] {
+		return fmt.Errorf("invalid journal mode %q: use WAL, DELETE, TRUNCATE, PERSIST, MEMORY or OFF", o.journalMode)
+	}
+	o.synchronous = strings.ToUpper(strings.TrimSpace(o.synchronous))
+	if !synchronousLevels[o.synchronous] {
+		return fmt.Errorf("invalid synchronous level %q: use OFF, NORMAL, FULL or EXTRA", o.synchronous)
+	}
+	if o.busyTimeoutMS < 0 {
+		return fmt.Errorf("invalid busy timeout %d ms: must not be negative", o.busyTimeoutMS)
+	}
+	return nil
+}
+
+// Option configures how New opens the database.
+type Option func(*options)
+
+// WithJournalMode sets the SQLite journal mode, e.g. "WAL" (default) or "DELETE" for
+// network drives where WAL does not work. Case is ignored; New rejects unknown modes.
+func WithJournalMode(mode string) Option {
+	return func(o *options) {
+		o.journalMode = mode
+	}
+}
+
+// WithSynchronous sets the SQLite synchronous level, e.g. "FULL" (default) or "NORMAL".
+// Case is ignored; New rejects unknown levels.
+func WithSynchronous(level string) Option {
+	return func(o *options) {
+		o.synchronous = level
+	}
+}
+
+// WithBusyTimeout makes SQLite wait up to ms milliseconds for a lock instead of failing right
+// away with "database is locked". The timeout is always passed to the driver, so 0, the
+// default, really does not wait (the driver alone would wait 5 s).
+func WithBusyTimeout(ms int) Option {
+	return func(o *options) {
+		o.busyTimeoutMS = ms
+	}
+}
+
+// WithEncryptionKey opens the database encrypted with SQLCipher, the key being derived from
+// passphrase. It needs a binary built with the sqlcipher tag (see cipher_sqlcipher.go).
+func WithEncryptionKey(passphrase string) Option {
+	return func(o *options) {
+		o.encryptionKey = passphrase
+	}
+}
+
+// New creates the database, encrypted when a passphrase is given through WithEncryptionKey
+// or EncryptionKeyEnv and a standard unencrypted one otherwise
+func New(dbPath string, opts ...Option) (*DB, error) {
 	log.Printf("Initializing database connection at: %s", dbPath)
 
+	o := options{journalMode: "WAL", synchronous: "FULL", encryptionKey: os.Getenv(EncryptionKeyEnv)}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if err := o.validate(); err != nil {
+		return nil, err
+	}
+
 	// Configure GORM with better settings for Windows
 	config := &gorm.Config{
 		Logger: logger.Default.LogMode(logger.Info),
 	}
 
 	// Open SQLite database with additional pragmas for Windows compatibility
-	dsn := fmt.Sprintf("%s?_journal_mode=WAL&_synchronous=FULL&_cache_size=1000&_foreign_keys=on", dbPath)
-	db, err := gorm.Open(sqlite.Open(dsn), config)
+	dsn := fmt.Sprintf("%s?_synchronous=%s&_cache_size=1000&_foreign_keys=on&_busy_timeout=%d", dbPath, o.synchronous, o.busyTimeoutMS)
+	var dialector gorm.Dialector
+	if o.encryptionKey != "" {
+		// The journal mode reads the file, so it can only be set once the key is in place
+		var err error
+		dialector, err = encryptedDialector(dsn, o.encryptionKey, o.journalMode)
+		if err != nil {
+			return nil, err
+		}
+		log.Println("Opening encrypted database")
+	} else {
+		dialector = sqlite.Open(dsn + "&_journal_mode=" + o.journalMode)
+	}
+	db, err := gorm.Open(dialector, config)
 	if err != nil {
 		return nil, fmt.Errorf("failed to open database: %v", err)
 	}
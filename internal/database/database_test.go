@@ -0,0 +1,36 @@
+package database
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestNewRejectsUnknownPragmas(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "app.db")
+	tests := []struct {
+		name string
+		opt  Option
+	}{
+		{name: "journal mode", opt: WithJournalMode("WAL; DROP TABLE players")},
+		{name: "empty journal mode", opt: WithJournalMode("")},
+		{name: "synchronous", opt: WithSynchronous("FAST")},
+		{name: "busy timeout", opt: WithBusyTimeout(-1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := New(dbPath, tt.opt); err == nil {
+				t.Error("New accepted an invalid value")
+			}
+		})
+	}
+}
+
+func TestOptionsValidateNormalizes(t *testing.T) {
+	o := options{journalMode: " delete ", synchronous: "normal"}
+	if err := o.validate(); err != nil {
+		t.Fatal(err)
+	}
+	if o.journalMode != "DELETE" || o.synchronous != "NORMAL" {
+		t.Errorf("normalized to %q / %q, want DELETE / NORMAL", o.journalMode, o.synchronous)
+	}
+}
@@ -1,8 +1,11 @@
 package database
 
 import (
+	"crypto/rand"
+	"encoding/hex"
 	"fmt"
 	"log"
+	"os"
 
 	"github.com/google/uuid"
 	"golang.org/x/crypto/bcrypt"
@@ -12,6 +15,15 @@ import (
 	"gorm.io/gorm"
 )
 
+// migratedModels are the models RunMigrations creates tables for.
+var migratedModels = []interface{}{
+	&model.Administrator{},
+	&model.Player{},
+	&model.Match{},
+	&model.Round{},
+	&model.Tournament{},
+}
+
 // RunMigrations performs database migrations for all models using GORM
 func RunMigrations(db *gorm.DB) error {
 	log.Println("Running database migrations...")
@@ -19,17 +31,19 @@ func RunMigrations(db *gorm.DB) error {
 	// Enable foreign key constraints in SQLite
 	db.Exec("PRAGMA foreign_keys = ON;")
 
+	// Tournaments stored before CountForfeitsInTiebreaks existed counted forfeits
+	addForfeitsColumn := !db.Migrator().HasColumn(&model.Tournament{}, "CountForfeitsInTiebreaks")
+
 	// Use GORM's AutoMigrate to handle all migrations
-	err := db.AutoMigrate(
-		&model.Administrator{},
-		&model.Player{},
-		&model.Match{},
-		&model.Round{},
-		&model.Tournament{},
-	)
+	err := db.AutoMigrate(migratedModels...)
 	if err != nil {
 		return fmt.Errorf("failed to auto-migrate models: %v", err)
 	}
+	if addForfeitsColumn {
+		if err := db.Exec("UPDATE tournaments SET count_forfeits_in_tiebreaks = ?", true).Error; err != nil {
+			return fmt.Errorf("failed to set count_forfeits_in_tiebreaks: %v", err)
+		}
+	}
 	log.Println("GORM AutoMigrate completed for all models.")
 
 	// Seed initial data
@@ -41,6 +55,76 @@ func RunMigrations(db *gorm.DB) error {
 	return nil
 }
 
+// Environment variables configuring the seeded SUDO account. Without a password a random one
+// is generated and written to the log once, when the account is created.
+const (
+	SudoUsernameEnv = "XCHESS_SUDO_USERNAME"
+	SudoPasswordEnv = "XCHESS_SUDO_PASSWORD"
+)
+
+// defaultSudoUsername is the SUDO account's username when SudoUsernameEnv is unset.
+const defaultSudoUsername = "sudo"
+
+// randomPassword returns a random 16-character hex password.
+func randomPassword() (string, error) {
+	buf := make([]byte, 8)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// seedSudoAccount creates the SUDO account unless an account with that role already exists.
+func seedSudoAccount(db *gorm.DB) error {
+	var sudoCount int64
+	if err := db.Model(&model.Administrator{}).Where("role = ?", model.Sudo).Count(&sudoCount).Error; err != nil {
+		return fmt.Errorf("failed to count administrators: %v", err)
+	}
+	if sudoCount > 0 {
+		return nil
+	}
+
+	username := os.Getenv(SudoUsernameEnv)
+	if username == "" {
+		username = defaultSudoUsername
+	}
+	var existing int64
+	if err := db.Model(&model.Administrator{}).Where("username = ?", username).Count(&existing).Error; err != nil {
+		return fmt.Errorf("failed to query administrator: %v", err)
+	}
+	if existing > 0 {
+		return fmt.Errorf("cannot seed SUDO account: username %q is already taken by a non-SUDO account", username)
+	}
+
+	password, generated := os.Getenv(SudoPasswordEnv), false
+	if password == "" {
+		var err error
+		if password, err = randomPassword(); err != nil {
+			return fmt.Errorf("failed to generate SUDO password: %v", err)
+		}
+		generated = true
+	}
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return fmt.Errorf("failed to hash SUDO password: %v", err)
+	}
+	sudo := model.Administrator{
+		ID:       uuid.New(),
+		Username: username,
+		Password: string(hashedPassword),
+		Role:     model.Sudo,
+	}
+	if err := db.Create(&sudo).Error; err != nil {
+		return fmt.Errorf("failed to create SUDO account: %v", err)
+	}
+	if generated {
+		log.Printf("SUDO account %q seeded with password %s (shown only once, store it safely)", username, password)
+	} else {
+		log.Printf("SUDO account %q seeded with the password from %s", username, SudoPasswordEnv)
+	}
+	return nil
+}
+
 // SeedInitialData seeds the database with minimal, essential data
 func SeedInitialData(db *gorm.DB) error {
 	log.Println("Seeding initial data...")
@@ -69,6 +153,12 @@ func SeedInitialData(db *gorm.DB) error {
 		}
 	}
 
+	// Destructive operations require SUDO: seed a separate account holding it when there is none
+	// (databases where "admin" was already made SUDO keep using that account)
+	if err := seedSudoAccount(db); err != nil {
+		return err
+	}
+
 	// Seed initial players only if none exist - use transaction for Windows reliability
 	var count int64
 	if err := db.Model(&model.Player{}).Count(&count).Error; err != nil {
@@ -0,0 +1,23 @@
+package database
+
+import (
+	"testing"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// Every model must parse into a gorm schema: a field gorm cannot map (e.g. a slice without a
+// serializer) fails AutoMigrate, and with it RunMigrations and the app start.
+func TestAutoMigrateEveryModel(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, m := range migratedModels {
+		if err := db.AutoMigrate(m); err != nil {
+			t.Errorf("AutoMigrate(%T): %v", m, err)
+		}
+	}
+}
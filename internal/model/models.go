@@ -19,6 +19,20 @@ const (
 	Admin Role = "ADMIN"
 )
 
+// MatchResult is the outcome recorded for a match; empty while the game is pending
+type MatchResult string
+
+const (
+	ResultAWin          MatchResult = "A_WIN"
+	ResultBWin          MatchResult = "B_WIN"
+	ResultDraw          MatchResult = "DRAW"
+	ResultByeA          MatchResult = "BYE_A"
+	ResultByeB          MatchResult = "BYE_B"
+	ResultAForfeit      MatchResult = "A_FORFEIT"
+	ResultBForfeit      MatchResult = "B_FORFEIT"
+	ResultDoubleForfeit MatchResult = "DOUBLE_FORFEIT"
+)
+
 // ===== MODELS =====
 
 // Administrator represents a system administrator with access controls
@@ -38,11 +52,23 @@ type Player struct {
 	Score            float64            `json:"score"`                           // Current total points (e.g., 1.0 for Win, 0.5 for Draw)
 	OpponentIDs      []string           `json:"opponent_ids" gorm:"type:json"`   // List of IDs of players already faced (Crucial for Swiss Pairing)
 	Buchholz         float64            `json:"buchholz"`                        // Tie-breaker: Sum of opponents' scores
+	BuchholzCut1     float64            `json:"buchholz_cut1"`                   // Tie-breaker: Buchholz without the lowest opponent score
+	BuchholzCut2     float64            `json:"buchholz_cut2"`                   // Tie-breaker: Buchholz without the two lowest opponent scores
+	SonnebornBerger  float64            `json:"sonneborn_berger"`                // Tie-breaker: Points scored vs each opponent times that opponent's score
+	AvgOpponentRating float64           `json:"avg_opponent_rating"`             // Tie-breaker: Average rating of rated opponents (0 if none are rated)
 	ProgressiveScore float64            `json:"progressive_score"`               // Tie-breaker: Cumulative score after each round
-	HeadToHeadResults HeadToHeadMap      `json:"head_to_head_results" gorm:"type:json"` // Tie-breaker: Results vs specific opponents (opponent_id -> score)
+	HeadToHeadResults HeadToHeadMap      `json:"head_to_head_results" gorm:"type:json"` // Tie-breaker: Points scored vs specific opponents, summed over all games (opponent_id -> score)
 	ColorHistory     string             `json:"color_history"`                   // E.g., "WBW" (White, Black, White) to track color imbalance
 	HasBye           bool               `json:"has_bye"`                         // True if the player has received a bye
+	ByeRounds        []int              `json:"bye_rounds,omitempty" gorm:"serializer:json"` // Rounds in which the player scored a bye (pairing or requested)
 	Club             string             `json:"club,omitempty"`                  // Player's chess club (optional)
+	Rating           int                `json:"rating,omitempty"`                // Player's rating (optional, 0 = unrated)
+	GamesPlayed      int                `json:"games_played,omitempty"`          // Career games played over the board (no byes or forfeits); picks the Elo K-factor
+	Team             string             `json:"team,omitempty"`                  // Team name in team events (optional)
+	Eliminated       bool               `json:"eliminated,omitempty"`            // Knockout only: lost a match and drops out of pairing
+	Withdrawn        bool               `json:"withdrawn,omitempty"`             // Left the tournament: no longer paired, past results kept
+	WithdrawnInRound int                `json:"withdrawn_in_round,omitempty"`    // Round the player withdrew in (0 = before round 1); later games are ignored
+	StartRank        int                `json:"start_rank,omitempty"`            // Seed number: 1..n by Rating desc at InitializeTournament, fixed from then on
 }
 
 // HeadToHeadMap is a custom type for GORM serialization
@@ -88,16 +114,35 @@ type Match struct {
 	WhiteID string `json:"white_id"`
 	BlackID string `json:"black_id"`
 
-	Result string  `json:"result"`  // E.g., "A_WIN", "B_WIN", "DRAW", "BYE_A"
-	ScoreA float64 `json:"score_a"` // Points awarded to Player A
-	ScoreB float64 `json:"score_b"` // Points awarded to Player B
+	Result MatchResult `json:"result"`  // One of the Result* constants ("" while pending)
+	ScoreA float64     `json:"score_a"` // Points awarded to Player A
+	ScoreB float64     `json:"score_b"` // Points awarded to Player B
+
+	Forfeit bool `json:"forfeit,omitempty"` // True when the game was not played (A_FORFEIT, B_FORFEIT, DOUBLE_FORFEIT)
+
+	ResultRecordedAt *time.Time `json:"result_recorded_at,omitempty"` // When the current result was entered (nil while pending)
+
+	FloatType string `json:"float_type,omitempty"` // "UP" or "DOWN" when Player A was paired outside their score group ("" otherwise)
+
+	RequestedBye bool `json:"requested_bye,omitempty"` // True when the bye was requested in advance by Player A (not a pairing bye)
+	ByeValue     float64 `json:"bye_value,omitempty"` // Points for this bye, set when it is assigned (0 = unset: the tournament's ByeScore)
+
+	BracketPosition int `json:"bracket_position,omitempty"` // Knockout only: 1-based slot of the match in its bracket round
 }
 
 // Round encapsulates all matches played in a single step of the tournament.
 type Round struct {
-	RoundNumber int     `json:"round_number"`
-	Matches     []Match `json:"matches" gorm:"type:json"`
-	IsComplete  bool    `json:"is_complete"`
+	RoundNumber int        `json:"round_number"`
+	Matches     []Match    `json:"matches" gorm:"type:json"`
+	IsComplete  bool       `json:"is_complete"`
+	PairedAt    *time.Time `json:"paired_at,omitempty"` // When the pairings for this round were generated
+
+	RoundStartTime       *time.Time `json:"round_start_time,omitempty"`       // When the round clock started
+	RoundDurationMinutes int        `json:"round_duration_minutes,omitempty"` // Time allowed for the round (0 = no time control)
+
+	PairingWarnings []string `json:"pairing_warnings,omitempty" gorm:"serializer:json"` // Constraints relaxed to pair this round, e.g. "Round 5 required 1 rematch"
+
+	Cancelled bool `json:"cancelled,omitempty"` // Set on rounds archived by CancelCurrentRound (kept in CancelledRoundsData, never paired or scored)
 }
 
 // Tournament holds the overall state and history of a Swiss-system event.
@@ -106,11 +151,16 @@ type Tournament struct {
 	Title       string    `json:"title" gorm:"not null"`
 	Description string    `json:"description" gorm:"not null"`
 	Status      string    `json:"status" gorm:"not null"` // "SETUP", "ACTIVE", "COMPLETE"
+	Archived    bool      `json:"archived"`               // Hidden from ListTournaments by default; the data is untouched
 
 	// Core data for Swiss logic (stored as JSON in the database for single record management)
-	PlayersData json.RawMessage `json:"players_data" gorm:"column:players;type:json"`
-	RoundsData  json.RawMessage `json:"rounds_data" gorm:"column:rounds;type:json"`
-	EventsData  json.RawMessage `json:"events_data" gorm:"column:events;type:json"`
+	PlayersData     json.RawMessage `json:"players_data" gorm:"column:players;type:json"`
+	RoundsData      json.RawMessage `json:"rounds_data" gorm:"column:rounds;type:json"`
+	EventsData      json.RawMessage `json:"events_data" gorm:"column:events;type:json"`
+	ByeRequestsData json.RawMessage `json:"bye_requests_data" gorm:"column:bye_requests;type:json"`
+	RedoData        json.RawMessage `json:"redo_data" gorm:"column:redo;type:json"` // Undone events that can be reapplied (last = next redo)
+	CancelledRoundsData json.RawMessage `json:"cancelled_rounds_data" gorm:"column:cancelled_rounds;type:json"` // Rounds archived by CancelCurrentRound (last = most recent)
+	TiebreakMatchesData json.RawMessage `json:"tiebreak_matches_data" gorm:"column:tiebreak_matches;type:json"` // Armageddon games after the last round; never part of a round
 
 	// Summary/Metadata
 	CurrentRound int        `json:"current_round" gorm:"not null"`
@@ -122,11 +172,59 @@ type Tournament struct {
 	RoundsTotal   int     `json:"rounds_total,omitempty"`
 	ByeScore      float64 `json:"bye_score,omitempty"`
 	PairingSystem string  `json:"pairing_system,omitempty"` // e.g., "SWISS"
+	Accelerated   bool    `json:"accelerated,omitempty"`    // Accelerated pairings: virtual +1.0 for the top half in rounds 1-2
+	DoubleRound   bool    `json:"double_round,omitempty"`   // Every pairing is played twice, the second game with colors reversed
+	PairingSeed   int64   `json:"pairing_seed,omitempty"`   // Seed for the random first-round pairing (0 = chosen when round 1 is paired)
+	FirstRoundMethod string `json:"first_round_method,omitempty"` // "RANDOM" (default) or "RATING" (top half vs bottom half by rating)
+	FirstRoundTopColor string `json:"first_round_top_color,omitempty"` // Round 1 color of the higher seed: "WHITE", "BLACK" or "ALTERNATE" by table (empty = method default)
+	ForbiddenPairs   [][2]string `json:"forbidden_pairs,omitempty" gorm:"serializer:json"` // Player ID pairs that must not be paired (treated as already played)
+	ByePolicy        string      `json:"bye_policy,omitempty"`                              // Who gets the pairing bye from round 2 on: "LOWEST" (default), "HIGHEST" or "RANDOM"
+	ByeByRating      bool        `json:"bye_by_rating,omitempty"`                           // Give the pairing bye to the lowest rated player instead (overrides ByePolicy)
+	RematchWindow    int         `json:"rematch_window,omitempty"`                          // Players may not meet again within this many rounds (0 = never again)
+	MaxPlayers       int         `json:"max_players,omitempty"`                             // Soft limit: round 1 is paired with a warning above it (0 = no limit)
+	AllowDuplicateNames bool     `json:"allow_duplicate_names,omitempty"`                   // Let AddPlayer/UpdatePlayer accept a name already in the tournament
+
+	// Elo K-factors (0 = default: K 40 below 10 career games, K 20 from then on)
+	ProvisionalGames   int `json:"provisional_games,omitempty"`
+	KFactorProvisional int `json:"k_factor_provisional,omitempty"`
+	KFactorEstablished int `json:"k_factor_established,omitempty"`
+
+	RoundDurationMinutes int `json:"round_duration_minutes,omitempty"` // Default time allowed per round, copied onto each new round (0 = none)
+
+	// Points system (defaults 1 / 0.5 / 0; PointsWin == 0 means unset)
+	PointsWin  float64 `json:"points_win,omitempty"`
+	PointsDraw float64 `json:"points_draw,omitempty"`
+	PointsLoss float64 `json:"points_loss,omitempty"`
+
+	// Standings configuration
+	TieBreakOrder []string `json:"tie_break_order,omitempty" gorm:"serializer:json"` // e.g., ["BUCHHOLZ_CUT1","SONNEBORN","PROGRESSIVE"]; empty = default order
+	UseFIDEBuchholz bool   `json:"use_fide_buchholz,omitempty"` // Count unplayed rounds (byes, absences) in Buchholz against a FIDE virtual opponent
+	CountForfeitsInTiebreaks bool `json:"count_forfeits_in_tiebreaks"` // True (set by InitializeTournament) counts forfeits like played games; false = strict FIDE, left out of Buchholz and Sonneborn-Berger
+	ClubScoringBoards int  `json:"club_scoring_boards,omitempty"` // Best members per club counted in the club standings (0 = all)
 
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
 
+// TournamentSummary is a lightweight view of a stored tournament, without the JSON blobs.
+type TournamentSummary struct {
+	ID           uuid.UUID  `json:"id"`
+	Title        string     `json:"title"`
+	Status       string     `json:"status"`
+	TotalPlayers int        `json:"total_players"`
+	CurrentRound int        `json:"current_round"`
+	StartTime    time.Time  `json:"start_time"`
+	EndTime      *time.Time `json:"end_time"`
+	Archived     bool       `json:"archived"`
+}
+
+// ByeRequest is a bye requested in advance by a player who cannot attend a round.
+type ByeRequest struct {
+	PlayerID    string  `json:"player_id"`
+	RoundNumber int     `json:"round_number"`
+	Value       float64 `json:"value"` // Points awarded for the bye (typically 0.5)
+}
+
 // Event represents a tournament event for audit trail and detailed reporting
 type Event struct {
 	EventID     uuid.UUID       `json:"event_id"`
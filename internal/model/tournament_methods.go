@@ -1,6 +1,13 @@
 package model
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
 
 // GetPlayers deserializes the PlayersData field into a slice of Player structs.
 func (t Tournament) GetPlayers() ([]Player, error) {
@@ -68,4 +75,104 @@ func (t *Tournament) SetEvents(events []Event) error {
 	}
 	t.EventsData = data
 	return nil
-}
\ No newline at end of file
+}
+
+// GetByeRequests deserializes the ByeRequestsData field into a slice of ByeRequest structs.
+func (t Tournament) GetByeRequests() ([]ByeRequest, error) {
+	var requests []ByeRequest
+	if t.ByeRequestsData == nil {
+		return requests, nil
+	}
+	err := json.Unmarshal(t.ByeRequestsData, &requests)
+	return requests, err
+}
+
+// SetByeRequests serializes a slice of ByeRequest structs into the ByeRequestsData field.
+func (t *Tournament) SetByeRequests(requests []ByeRequest) error {
+	data, err := json.Marshal(requests)
+	if err != nil {
+		return err
+	}
+	t.ByeRequestsData = data
+	return nil
+}
+
+// GetRedoEvents deserializes the RedoData field into a slice of Event structs.
+func (t Tournament) GetRedoEvents() ([]Event, error) {
+	var events []Event
+	if t.RedoData == nil {
+		return events, nil
+	}
+	err := json.Unmarshal(t.RedoData, &events)
+	return events, err
+}
+
+// SetRedoEvents serializes a slice of Event structs into the RedoData field.
+func (t *Tournament) SetRedoEvents(events []Event) error {
+	data, err := json.Marshal(events)
+	if err != nil {
+		return err
+	}
+	t.RedoData = data
+	return nil
+}
+
+// GetCancelledRounds deserializes the CancelledRoundsData field into a slice of Round structs.
+func (t Tournament) GetCancelledRounds() ([]Round, error) {
+	var rounds []Round
+	if t.CancelledRoundsData == nil {
+		return rounds, nil
+	}
+	err := json.Unmarshal(t.CancelledRoundsData, &rounds)
+	return rounds, err
+}
+
+// SetCancelledRounds serializes a slice of Round structs into the CancelledRoundsData field.
+func (t *Tournament) SetCancelledRounds(rounds []Round) error {
+	data, err := json.Marshal(rounds)
+	if err != nil {
+		return err
+	}
+	t.CancelledRoundsData = data
+	return nil
+}
+
+// GetTiebreakMatches deserializes the TiebreakMatchesData field into a slice of Match structs.
+func (t Tournament) GetTiebreakMatches() ([]Match, error) {
+	var matches []Match
+	if t.TiebreakMatchesData == nil {
+		return matches, nil
+	}
+	err := json.Unmarshal(t.TiebreakMatchesData, &matches)
+	return matches, err
+}
+
+// SetTiebreakMatches serializes a slice of Match structs into the TiebreakMatchesData field.
+func (t *Tournament) SetTiebreakMatches(matches []Match) error {
+	data, err := json.Marshal(matches)
+	if err != nil {
+		return err
+	}
+	t.TiebreakMatchesData = data
+	return nil
+}
+
+// BeforeSave is a GORM hook keeping the derived fields in step with the data:
+// TotalPlayers is recounted from PlayersData and UpdatedAt is refreshed.
+func (t *Tournament) BeforeSave(tx *gorm.DB) error {
+	players, err := t.GetPlayers()
+	if err != nil {
+		return fmt.Errorf("invalid players data: %w", err)
+	}
+	t.TotalPlayers = len(players)
+	t.UpdatedAt = time.Now()
+	return nil
+}
+
+// BeforeCreate is a GORM hook assigning a new ID to a tournament stored without one.
+func (t *Tournament) BeforeCreate(tx *gorm.DB) error {
+	if t.ID == uuid.Nil {
+		t.ID = uuid.New()
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestBeforeSaveDerivesFields(t *testing.T) {
+	tour := &Tournament{TotalPlayers: 99}
+	if err := tour.SetPlayers([]Player{{ID: "p1"}, {ID: "p2"}, {ID: "p3"}}); err != nil {
+		t.Fatal(err)
+	}
+	start := time.Now()
+	if err := tour.BeforeSave(nil); err != nil {
+		t.Fatal(err)
+	}
+	if tour.TotalPlayers != 3 {
+		t.Errorf("TotalPlayers = %d, want 3", tour.TotalPlayers)
+	}
+	if tour.UpdatedAt.Before(start) {
+		t.Errorf("UpdatedAt = %v, want at least %v", tour.UpdatedAt, start)
+	}
+
+	tour.PlayersData = []byte("not json")
+	if err := tour.BeforeSave(nil); err == nil {
+		t.Error("BeforeSave accepted invalid players data")
+	}
+}
+
+func TestBeforeCreateAssignsID(t *testing.T) {
+	tour := &Tournament{}
+	if err := tour.BeforeCreate(nil); err != nil {
+		t.Fatal(err)
+	}
+	if tour.ID == uuid.Nil {
+		t.Fatal("BeforeCreate left the ID empty")
+	}
+
+	// An existing ID is kept
+	id := uuid.New()
+	tour.ID = id
+	if err := tour.BeforeCreate(nil); err != nil {
+		t.Fatal(err)
+	}
+	if tour.ID != id {
+		t.Errorf("BeforeCreate replaced ID %s with %s", id, tour.ID)
+	}
+}
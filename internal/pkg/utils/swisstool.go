@@ -6,6 +6,7 @@ import (
 	"io"
 	"math/rand"
 	"sort"
+	"time"
 
 	"github.com/olekukonko/tablewriter"
 )
@@ -28,6 +29,16 @@ const (
 
 )
 
+// TiebreakMode selects the tiebreakers GetStandings ranks tied players by
+type TiebreakMode int
+
+const (
+	// TIEBREAK_PERCENTAGE uses opponent match win %, game win % and opponent game win % (Magic style)
+	TIEBREAK_PERCENTAGE TiebreakMode = iota
+	// TIEBREAK_MEDIAN_BUCHHOLZ uses median Buchholz, then Buchholz, on opponents' match points (chess style)
+	TIEBREAK_MEDIAN_BUCHHOLZ
+)
+
 // TournamentConfig holds configuration options for tournaments
 type TournamentConfig struct {
 	PointsForWin  int // Points awarded for a win
@@ -56,8 +67,10 @@ type Tournament struct {
 	players      map[int]Player
 	currentRound int
 	rounds       []Round
-	started      bool // Whether the tournament has started (first round paired)
-	finished     bool // Whether the tournament has finished
+	started      bool         // Whether the tournament has started (first round paired)
+	finished     bool         // Whether the tournament has finished
+	rng          *rand.Rand   // Source for random pairings and shuffles
+	tiebreakMode TiebreakMode // Tiebreakers used to rank players tied on points
 }
 
 type Player struct {
@@ -88,7 +101,14 @@ func NewTournament() Tournament {
 	return NewTournamentWithConfig(DefaultConfig())
 }
 
+// NewTournamentWithConfig creates a tournament whose random pairings are seeded from the clock.
 func NewTournamentWithConfig(config TournamentConfig) Tournament {
+	return NewTournamentWithConfigAndSeed(config, time.Now().UnixNano())
+}
+
+// NewTournamentWithConfigAndSeed creates a tournament whose random pairings and shuffles
+// come from rand.New(rand.NewSource(seed)), so the same seed reproduces the same pairings.
+func NewTournamentWithConfigAndSeed(config TournamentConfig, seed int64) Tournament {
 	tournament := Tournament{}
 	tournament.config = config
 	tournament.lastId = 0
@@ -97,9 +117,15 @@ func NewTournamentWithConfig(config TournamentConfig) Tournament {
 	tournament.rounds = make([]Round, 2) // Initialize with capacity for rounds 0 and 1
 	tournament.started = false
 	tournament.finished = false
+	tournament.rng = rand.New(rand.NewSource(seed))
 	return tournament
 }
 
+// SetTiebreakMode selects the tiebreakers used by GetStandings (TIEBREAK_PERCENTAGE by default)
+func (t *Tournament) SetTiebreakMode(mode TiebreakMode) {
+	t.tiebreakMode = mode
+}
+
 func (t *Tournament) AddPlayer(name string) error {
 	if name == "" {
 		return errors.New("empty name")
@@ -296,13 +322,13 @@ func (t *Tournament) RemovePlayerByName(name string) error {
 
 // removeRandomPlayer selects a random player from the slice and returns both
 // the selected player and a new slice with that player removed.
-func removeRandomPlayer(players []int) (int, []int) {
+func (t *Tournament) removeRandomPlayer(players []int) (int, []int) {
 	if len(players) == 0 {
 		panic("cannot remove player from empty slice")
 	}
 
 	// Pick random index
-	index := rand.Intn(len(players))
+	index := t.rng.Intn(len(players))
 	selectedPlayer := players[index]
 
 	// Swap selected player with last element and shrink slice
@@ -498,6 +524,8 @@ type TiebreakerData struct {
 	GameWinPercentage   float64 // Games won / total games played
 	OpponentMatchWinPct float64 // Average match win percentage of opponents
 	OpponentGameWinPct  float64 // Average game win percentage of opponents
+	Buchholz            int     // Sum of opponents' match points
+	MedianBuchholz      int     // Buchholz without the highest and lowest opponent (with 3+ opponents)
 }
 
 // PlayerStanding represents a player's position in the tournament standings
@@ -526,6 +554,7 @@ func (t *Tournament) calculateTiebreakers(playerID int) TiebreakerData {
 	// Calculate opponent match win percentages
 	opponentMatchWinPcts := []float64{}
 	opponentGameWinPcts := []float64{}
+	opponentPoints := []int{}
 
 	for round := 1; round < t.currentRound; round++ {
 		if round >= len(t.rounds) {
@@ -553,6 +582,8 @@ func (t *Tournament) calculateTiebreakers(playerID int) TiebreakerData {
 				continue
 			}
 
+			opponentPoints = append(opponentPoints, opponent.points)
+
 			// Calculate opponent's match win percentage
 			totalMatches := opponent.wins + opponent.losses + opponent.draws
 			if totalMatches > 0 {
@@ -597,11 +628,52 @@ func (t *Tournament) calculateTiebreakers(playerID int) TiebreakerData {
 		avgOpponentGameWinPct = sum / float64(len(opponentGameWinPcts))
 	}
 
+	// Calculate Buchholz and median Buchholz from opponents' match points
+	buchholz := 0
+	for _, points := range opponentPoints {
+		buchholz += points
+	}
+	medianBuchholz := buchholz
+	if len(opponentPoints) > 2 {
+		sort.Ints(opponentPoints)
+		medianBuchholz = buchholz - opponentPoints[0] - opponentPoints[len(opponentPoints)-1]
+	}
+
 	return TiebreakerData{
 		GameWinPercentage:   gameWinPct,
 		OpponentMatchWinPct: avgOpponentMatchWinPct,
 		OpponentGameWinPct:  avgOpponentGameWinPct,
+		Buchholz:            buchholz,
+		MedianBuchholz:      medianBuchholz,
+	}
+}
+
+// compareTiebreakers returns a positive number when a ranks above b under the tournament's
+// tiebreak mode, a negative number when b ranks above a and 0 when they are fully tied
+func (t *Tournament) compareTiebreakers(a, b TiebreakerData) int {
+	if t.tiebreakMode == TIEBREAK_MEDIAN_BUCHHOLZ {
+		if a.MedianBuchholz != b.MedianBuchholz {
+			return a.MedianBuchholz - b.MedianBuchholz
+		}
+		return a.Buchholz - b.Buchholz
+	}
+
+	// 1. Opponent match win percentage (first tiebreaker)
+	// 2. Game win percentage (second tiebreaker)
+	// 3. Opponent game win percentage (third tiebreaker)
+	for _, pair := range [][2]float64{
+		{a.OpponentMatchWinPct, b.OpponentMatchWinPct},
+		{a.GameWinPercentage, b.GameWinPercentage},
+		{a.OpponentGameWinPct, b.OpponentGameWinPct},
+	} {
+		if pair[0] > pair[1] {
+			return 1
+		}
+		if pair[0] < pair[1] {
+			return -1
+		}
 	}
+	return 0
 }
 
 // getSortedPlayersWithTiebreakers returns player IDs sorted by points and tiebreakers
@@ -624,23 +696,11 @@ func (t *Tournament) getSortedPlayersWithTiebreakers() []int {
 			return playerI.points > playerJ.points
 		}
 
-		// Then by tiebreakers
+		// Then by tiebreakers of the selected mode
 		tiebreakersI := t.calculateTiebreakers(players[i])
 		tiebreakersJ := t.calculateTiebreakers(players[j])
-
-		// 1. Opponent match win percentage (first tiebreaker)
-		if tiebreakersI.OpponentMatchWinPct != tiebreakersJ.OpponentMatchWinPct {
-			return tiebreakersI.OpponentMatchWinPct > tiebreakersJ.OpponentMatchWinPct
-		}
-
-		// 2. Game win percentage (second tiebreaker)
-		if tiebreakersI.GameWinPercentage != tiebreakersJ.GameWinPercentage {
-			return tiebreakersI.GameWinPercentage > tiebreakersJ.GameWinPercentage
-		}
-
-		// 3. Opponent game win percentage (third tiebreaker)
-		if tiebreakersI.OpponentGameWinPct != tiebreakersJ.OpponentGameWinPct {
-			return tiebreakersI.OpponentGameWinPct > tiebreakersJ.OpponentGameWinPct
+		if cmp := t.compareTiebreakers(tiebreakersI, tiebreakersJ); cmp != 0 {
+			return cmp > 0
 		}
 
 		// If still tied, maintain original order (effectively random within same tiebreaker group)
@@ -660,8 +720,9 @@ func (t *Tournament) getSortedPlayers() []int {
 		}
 	}
 
-	// Sort by points (descending) only
-	sort.Slice(players, func(i, j int) bool {
+	// Sort by points (descending) only, from a fixed order so the seed alone decides the shuffles
+	sort.Ints(players)
+	sort.SliceStable(players, func(i, j int) bool {
 		playerI := t.players[players[i]]
 		playerJ := t.players[players[j]]
 		return playerI.points > playerJ.points
@@ -686,7 +747,7 @@ func (t *Tournament) randomizeWithinPointGroups(players []int) {
 		if t.players[players[i]].points != currentPoints {
 			// Randomize the group from start to i-1
 			if i-start > 1 {
-				shufflePlayers(players[start:i])
+				t.shufflePlayers(players[start:i])
 			}
 			start = i
 			currentPoints = t.players[players[i]].points
@@ -695,14 +756,14 @@ func (t *Tournament) randomizeWithinPointGroups(players []int) {
 
 	// Don't forget the last group
 	if len(players)-start > 1 {
-		shufflePlayers(players[start:])
+		t.shufflePlayers(players[start:])
 	}
 }
 
 // shufflePlayers randomly shuffles a slice of player IDs
-func shufflePlayers(players []int) {
+func (t *Tournament) shufflePlayers(players []int) {
 	for i := len(players) - 1; i > 0; i-- {
-		j := rand.Intn(i + 1)
+		j := t.rng.Intn(i + 1)
 		players[i], players[j] = players[j], players[i]
 	}
 }
@@ -773,6 +834,8 @@ func (t *Tournament) randomPair() error {
 	for id := range t.players {
 		players = append(players, id)
 	}
+	// Map iteration order is random; sort so the seed alone decides the pairings
+	sort.Ints(players)
 
 	var pairings []Pairing
 	for len(players) > 0 {
@@ -789,8 +852,8 @@ func (t *Tournament) randomPair() error {
 		}
 
 		// Pick two random players using helper function
-		player0, remainingPlayers := removeRandomPlayer(players)
-		player1, finalPlayers := removeRandomPlayer(remainingPlayers)
+		player0, remainingPlayers := t.removeRandomPlayer(players)
+		player1, finalPlayers := t.removeRandomPlayer(remainingPlayers)
 		players = finalPlayers
 
 		// Create pairing between the two selected players
@@ -826,9 +889,7 @@ func (t *Tournament) GetStandings() []PlayerStanding {
 			prevTiebreakers := t.calculateTiebreakers(prevPlayerID)
 
 			if player.points == prevPlayer.points &&
-				tiebreakers.OpponentMatchWinPct == prevTiebreakers.OpponentMatchWinPct &&
-				tiebreakers.GameWinPercentage == prevTiebreakers.GameWinPercentage &&
-				tiebreakers.OpponentGameWinPct == prevTiebreakers.OpponentGameWinPct {
+				t.compareTiebreakers(tiebreakers, prevTiebreakers) == 0 {
 				// Same rank as previous player (tied)
 				nextRank = standings[len(standings)-1].Rank
 			} else {
@@ -0,0 +1,69 @@
+package tournament
+
+import (
+	"fmt"
+	"sort"
+
+	"xchess-desktop/internal/model"
+)
+
+// IndependentClub is the club name used in club standings for players without a club.
+const IndependentClub = "Independent"
+
+// ClubStanding is a club's total over its members in the tournament.
+type ClubStanding struct {
+	Club    string  `json:"club"`
+	Players int     `json:"players"` // Members in the tournament
+	Counted int     `json:"counted"` // Members whose score counts (the best ClubScoringBoards, or all)
+	Score   float64 `json:"score"`   // Sum of the counted members' scores
+}
+
+// SetClubScoringBoards limits each club's score in GetClubStandings to its best boards
+// members (0 counts every member).
+func SetClubScoringBoards(t *model.Tournament, boards int) error {
+	if boards < 0 {
+		return fmt.Errorf("club scoring boards cannot be negative, got %d", boards)
+	}
+	t.ClubScoringBoards = boards
+	return nil
+}
+
+// GetClubStandings sums the scores of each club's members, taken in standings order so that
+// with ClubScoringBoards set only the best placed members count. Players without a club are
+// grouped under IndependentClub. Sorted by Score desc, then Club.
+func GetClubStandings(t *model.Tournament) ([]ClubStanding, error) {
+	standings, err := GetStandings(t)
+	if err != nil {
+		return nil, err
+	}
+
+	byClub := make(map[string]*ClubStanding)
+	for _, p := range standings {
+		club := p.Club
+		if club == "" {
+			club = IndependentClub
+		}
+		s := byClub[club]
+		if s == nil {
+			s = &ClubStanding{Club: club}
+			byClub[club] = s
+		}
+		s.Players++
+		if t.ClubScoringBoards == 0 || s.Counted < t.ClubScoringBoards {
+			s.Counted++
+			s.Score += p.Score
+		}
+	}
+
+	result := make([]ClubStanding, 0, len(byClub))
+	for _, s := range byClub {
+		result = append(result, *s)
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].Score != result[j].Score {
+			return result[i].Score > result[j].Score
+		}
+		return result[i].Club < result[j].Club
+	})
+	return result, nil
+}
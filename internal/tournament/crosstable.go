@@ -0,0 +1,244 @@
+package tournament
+
+import (
+	"fmt"
+	"time"
+
+	"xchess-desktop/internal/model"
+
+	"github.com/johnfercher/maroto/v2"
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/config"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/consts/orientation"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// crosstableColumnsPerPage is how many opponent columns fit on one landscape section.
+// Larger fields are split into several sections, each repeating the player rows.
+const crosstableColumnsPerPage = 20
+
+// crosstableResults builds, for each player, the result symbols against every opponent
+// ("+" win, "-" loss, "=" draw; players who met more than once get one symbol per game)
+// and the bye symbols ("+" full point, "=" half point, "-" zero point) in round order.
+func crosstableResults(t *model.Tournament) (map[string]map[string]string, map[string]string, error) {
+	rounds, err := t.GetRounds()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get rounds: %w", err)
+	}
+
+	symbol := func(own, other float64) string {
+		switch {
+		case own > other:
+			return "+"
+		case own < other:
+			return "-"
+		}
+		return "="
+	}
+
+	games := make(map[string]map[string]string)
+	byes := make(map[string]string)
+	record := func(playerID, opponentID, s string) {
+		if games[playerID] == nil {
+			games[playerID] = make(map[string]string)
+		}
+		games[playerID][opponentID] += s
+	}
+
+	for _, r := range rounds {
+		if r.RoundNumber > t.CurrentRound {
+			continue
+		}
+		for _, m := range r.Matches {
+			if m.Result == "" {
+				continue
+			}
+			if isBye(m) {
+				recipient := byeRecipient(m)
+				own := m.ScoreA
+				if m.PlayerB_ID == recipient {
+					own = m.ScoreB
+				}
+				byes[recipient] += symbol(own, 0.5)
+				continue
+			}
+			record(m.PlayerA_ID, m.PlayerB_ID, symbol(m.ScoreA, m.ScoreB))
+			record(m.PlayerB_ID, m.PlayerA_ID, symbol(m.ScoreB, m.ScoreA))
+		}
+	}
+
+	return games, byes, nil
+}
+
+// ExportCrosstableToPDF generates a wall chart PDF: an NxN grid where cell (i,j) shows the
+// result of player i against player j, followed by the player's byes and total score.
+// Players are ordered by standings. Fields wider than crosstableColumnsPerPage are split
+// into several sections and the font shrinks as the field grows.
+func ExportCrosstableToPDF(t *model.Tournament) ([]byte, error) {
+	standings, err := GetStandings(t)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get standings: %w", err)
+	}
+
+	if len(standings) == 0 {
+		return nil, fmt.Errorf("no players found in tournament")
+	}
+
+	games, byes, err := crosstableResults(t)
+	if err != nil {
+		return nil, err
+	}
+
+	players, err := t.GetPlayers()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get players: %w", err)
+	}
+	startRank := startRanks(players)
+
+	n := len(standings)
+	perPage := n
+	if perPage > crosstableColumnsPerPage {
+		perPage = crosstableColumnsPerPage
+	}
+
+	fontSize := 8.0
+	switch {
+	case perPage > 14:
+		fontSize = 6
+	case perPage > 10:
+		fontSize = 7
+	}
+
+	// No (1) + Start rank (1) + Name (4) + one column per opponent + Bye (1) + Total (1)
+	gridSize := perPage + 8
+
+	cfg := config.NewBuilder().
+		WithPageNumber().
+		WithOrientation(orientation.Horizontal).
+		WithMaxGridSize(gridSize).
+		Build()
+
+	m := maroto.New(cfg)
+
+	// Add tournament title
+	m.AddRows(
+		row.New(10).Add(
+			col.New(gridSize).Add(
+				text.New(t.Title, props.Text{
+					Top:   2,
+					Style: fontstyle.Bold,
+					Align: align.Center,
+					Size:  16,
+				}),
+			),
+		),
+	)
+
+	m.AddRows(
+		row.New(10).Add(
+			col.New(gridSize).Add(
+				text.New(fmt.Sprintf("Tabel Silang - Round %d", t.CurrentRound), props.Text{
+					Top:   2,
+					Style: fontstyle.Bold,
+					Align: align.Center,
+					Size:  12,
+				}),
+			),
+		),
+	)
+
+	headerProps := props.Text{
+		Top:   1,
+		Style: fontstyle.Bold,
+		Align: align.Center,
+		Size:  fontSize,
+	}
+	cellProps := props.Text{
+		Top:   1,
+		Align: align.Center,
+		Size:  fontSize,
+	}
+	nameProps := cellProps
+	nameProps.Align = align.Left
+
+	for start := 0; start < n; start += perPage {
+		end := start + perPage
+		if end > n {
+			end = n
+		}
+
+		// Section header: opponents are numbered by their standings position
+		headerCols := []core.Col{
+			col.New(1).Add(text.New("No", headerProps)),
+			col.New(1).Add(text.New("SR", headerProps)),
+			col.New(4).Add(text.New("Nama", headerProps)),
+		}
+		for j := start; j < end; j++ {
+			headerCols = append(headerCols, col.New(1).Add(text.New(fmt.Sprintf("%d", j+1), headerProps)))
+		}
+		// Pad the last section so the Bye and Total columns stay aligned
+		for j := end; j < start+perPage; j++ {
+			headerCols = append(headerCols, col.New(1))
+		}
+		headerCols = append(headerCols,
+			col.New(1).Add(text.New("Bye", headerProps)),
+			col.New(1).Add(text.New("Poin", headerProps)),
+		)
+		m.AddRows(row.New(8).Add(headerCols...))
+
+		for i, player := range standings {
+			cols := []core.Col{
+				col.New(1).Add(text.New(fmt.Sprintf("%d", i+1), headerProps)),
+				col.New(1).Add(text.New(fmt.Sprintf("%d", startRank[player.ID]), cellProps)),
+				col.New(4).Add(text.New(player.Name, nameProps)),
+			}
+			for j := start; j < end; j++ {
+				if i == j {
+					// Diagonal cells are shaded
+					cols = append(cols, col.New(1).WithStyle(&props.Cell{
+						BackgroundColor: &props.Color{Red: 200, Green: 200, Blue: 200},
+					}))
+					continue
+				}
+				cols = append(cols, col.New(1).Add(text.New(games[player.ID][standings[j].ID], cellProps)))
+			}
+			for j := end; j < start+perPage; j++ {
+				cols = append(cols, col.New(1))
+			}
+			cols = append(cols,
+				col.New(1).Add(text.New(byes[player.ID], cellProps)),
+				col.New(1).Add(text.New(fmt.Sprintf("%.1f", player.Score), headerProps)),
+			)
+			m.AddRows(row.New(6).Add(cols...))
+		}
+
+		// Spacing between sections
+		m.AddRows(row.New(6))
+	}
+
+	// Add footer with timestamp
+	m.AddRows(
+		row.New(10).Add(
+			col.New(gridSize).Add(
+				text.New(time.Now().Format("2006-01-02 15:04:05"), props.Text{
+					Top:   3,
+					Align: align.Center,
+					Size:  8,
+				}),
+			),
+		),
+	)
+
+	// Generate PDF
+	document, err := m.Generate()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate PDF: %w", err)
+	}
+
+	return document.GetBytes(), nil
+}
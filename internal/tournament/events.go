@@ -0,0 +1,163 @@
+package tournament
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"xchess-desktop/internal/model"
+)
+
+// filterEvents returns the tournament's events accepted by keep, sorted by timestamp.
+func filterEvents(t *model.Tournament, keep func(e model.Event) bool) ([]model.Event, error) {
+	events, err := t.GetEvents()
+	if err != nil {
+		return nil, err
+	}
+
+	result := []model.Event{}
+	for _, e := range events {
+		if keep(e) {
+			result = append(result, e)
+		}
+	}
+	// Stable so events logged at the same instant keep their recorded order
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Timestamp.Before(result[j].Timestamp)
+	})
+	return result, nil
+}
+
+// GetEventsByType returns all events of the given type (e.g. "MATCH_RESULT_RECORDED"), oldest first.
+func GetEventsByType(t *model.Tournament, eventType string) ([]model.Event, error) {
+	return filterEvents(t, func(e model.Event) bool {
+		return e.Type == eventType
+	})
+}
+
+// GetEventsInRound returns all events logged for the given round, oldest first.
+func GetEventsInRound(t *model.Tournament, roundNumber int) ([]model.Event, error) {
+	return filterEvents(t, func(e model.Event) bool {
+		return e.RoundNumber == roundNumber
+	})
+}
+
+// GetAuditLog returns every event of the tournament, oldest first.
+func GetAuditLog(t *model.Tournament) ([]model.Event, error) {
+	return filterEvents(t, func(e model.Event) bool {
+		return true
+	})
+}
+
+// AuditEntry is the exported view of one event: the raw details plus a flattened summary.
+type AuditEntry struct {
+	EventID     string          `json:"event_id"`
+	Type        string          `json:"type"`
+	Timestamp   string          `json:"timestamp"` // RFC3339
+	RoundNumber int             `json:"round_number"`
+	TableNumber int             `json:"table_number"`
+	Summary     string          `json:"summary"`
+	Details     json.RawMessage `json:"details,omitempty"`
+}
+
+// summarizeDetails flattens an event's Details into "key=value" pairs sorted by key.
+// Nested values are kept as compact JSON; details that are not a JSON object are returned as is.
+func summarizeDetails(details json.RawMessage) string {
+	if len(details) == 0 {
+		return ""
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(details, &fields); err != nil {
+		return string(details)
+	}
+
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		value := string(fields[k])
+		var str string
+		if err := json.Unmarshal(fields[k], &str); err == nil {
+			value = str
+		} else {
+			var compact bytes.Buffer
+			if json.Compact(&compact, fields[k]) == nil {
+				value = compact.String()
+			}
+		}
+		parts = append(parts, fmt.Sprintf("%s=%s", k, value))
+	}
+	return strings.Join(parts, "; ")
+}
+
+// auditEntries returns the audit log (oldest first) as AuditEntry values.
+func auditEntries(t *model.Tournament) ([]AuditEntry, error) {
+	events, err := GetAuditLog(t)
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]AuditEntry, 0, len(events))
+	for _, e := range events {
+		entries = append(entries, AuditEntry{
+			EventID:     e.EventID.String(),
+			Type:        e.Type,
+			Timestamp:   e.Timestamp.Format(time.RFC3339),
+			RoundNumber: e.RoundNumber,
+			TableNumber: e.TableNumber,
+			Summary:     summarizeDetails(e.Details),
+			Details:     e.Details,
+		})
+	}
+	return entries, nil
+}
+
+// ExportEventsToJSON exports the audit log, oldest first, as an indented JSON array of AuditEntry.
+func ExportEventsToJSON(t *model.Tournament) ([]byte, error) {
+	entries, err := auditEntries(t)
+	if err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(entries, "", "  ")
+}
+
+// ExportEventsToCSV exports the audit log, oldest first, as CSV with a header row:
+// event_id, type, timestamp (RFC3339), round, table and the flattened details summary.
+func ExportEventsToCSV(t *model.Tournament) ([]byte, error) {
+	entries, err := auditEntries(t)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write([]string{"event_id", "type", "timestamp", "round", "table", "summary"}); err != nil {
+		return nil, err
+	}
+	for _, e := range entries {
+		record := []string{
+			e.EventID,
+			e.Type,
+			e.Timestamp,
+			strconv.Itoa(e.RoundNumber),
+			strconv.Itoa(e.TableNumber),
+			e.Summary,
+		}
+		if err := w.Write(record); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
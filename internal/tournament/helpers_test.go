@@ -0,0 +1,81 @@
+package tournament
+
+import (
+	"fmt"
+	"testing"
+
+	"xchess-desktop/internal/model"
+)
+
+// newTestTournament initializes a Swiss tournament of n players p1..pn with a fixed pairing
+// seed, so every run pairs the same way.
+func newTestTournament(t *testing.T, n int) *model.Tournament {
+	t.Helper()
+	tour := &model.Tournament{PairingSeed: 1}
+	players := make([]model.Player, 0, n)
+	for i := 1; i <= n; i++ {
+		players = append(players, model.Player{ID: fmt.Sprintf("p%d", i), Name: fmt.Sprintf("Player %d", i)})
+	}
+	if err := InitializeTournament(tour, "Test Open", "Test event", players); err != nil {
+		t.Fatal(err)
+	}
+	return tour
+}
+
+// currentMatches returns the matches of the tournament's current round.
+func currentMatches(t *testing.T, tour *model.Tournament) []model.Match {
+	t.Helper()
+	rounds, err := tour.GetRounds()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range rounds {
+		if r.RoundNumber == tour.CurrentRound {
+			return r.Matches
+		}
+	}
+	t.Fatalf("round %d not found", tour.CurrentRound)
+	return nil
+}
+
+// playRound pairs the next round and records a win for Player A on every board (byes get
+// their bye result).
+func playRound(t *testing.T, tour *model.Tournament) []model.Match {
+	t.Helper()
+	if err := AdvanceToNextRound(tour, SwissToolAdapter{}); err != nil {
+		t.Fatal(err)
+	}
+	matches := currentMatches(t, tour)
+	for _, m := range matches {
+		if m.Result != "" {
+			continue
+		}
+		result := model.ResultAWin
+		switch {
+		case m.PlayerB_ID == ByePlayerID:
+			result = model.ResultByeA
+		case m.PlayerA_ID == ByePlayerID:
+			result = model.ResultByeB
+		}
+		if err := RecordMatchResult(tour, tour.CurrentRound, m.TableNumber, result); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return matches
+}
+
+// playerByID returns the stored player with the given ID.
+func playerByID(t *testing.T, tour *model.Tournament, id string) model.Player {
+	t.Helper()
+	players, err := tour.GetPlayers()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range players {
+		if p.ID == id {
+			return p
+		}
+	}
+	t.Fatalf("player %s not found", id)
+	return model.Player{}
+}
@@ -0,0 +1,207 @@
+package tournament
+
+import (
+	"fmt"
+	"sort"
+
+	"xchess-desktop/internal/model"
+)
+
+// PairingSystemKnockout is the Tournament.PairingSystem value for single-elimination events.
+const PairingSystemKnockout = "KNOCKOUT"
+
+// KnockoutAdapter pairs a single-elimination bracket.
+// Round 1 seeds players by Rating (start order breaks ties) into a bracket sized to the next
+// power of two, giving the top seeds a bye. Later rounds pair the winners of neighbouring
+// bracket positions; losers are eliminated. When one player is left they are the champion.
+type KnockoutAdapter struct{}
+
+func init() {
+	RegisterEngine(PairingSystemKnockout, func() PairingEngine { return KnockoutAdapter{} })
+}
+
+// GeneratePairings implements PairingEngine for knockout events.
+func (k KnockoutAdapter) GeneratePairings(t *model.Tournament, players []model.Player, roundNumber int) ([]model.Match, error) {
+	if len(players) < 2 {
+		return nil, fmt.Errorf("knockout needs at least 2 players")
+	}
+
+	byID := make(map[string]*model.Player, len(players))
+	for i := range players {
+		byID[players[i].ID] = &players[i]
+	}
+
+	if roundNumber == 1 {
+		return seedKnockoutBracket(players), nil
+	}
+
+	rounds, err := t.GetRounds()
+	if err != nil {
+		return nil, err
+	}
+	var previous *model.Round
+	for i := range rounds {
+		if rounds[i].RoundNumber == roundNumber-1 {
+			previous = &rounds[i]
+			break
+		}
+	}
+	if previous == nil {
+		return nil, fmt.Errorf("round %d not found", roundNumber-1)
+	}
+
+	prevMatches := make([]model.Match, len(previous.Matches))
+	copy(prevMatches, previous.Matches)
+	sort.SliceStable(prevMatches, func(i, j int) bool {
+		return prevMatches[i].BracketPosition < prevMatches[j].BracketPosition
+	})
+
+	winners := make([]string, 0, len(prevMatches))
+	for _, m := range prevMatches {
+		winner, err := knockoutWinner(m)
+		if err != nil {
+			return nil, err
+		}
+		winners = append(winners, winner)
+	}
+	if len(winners) == 1 {
+		return nil, fmt.Errorf("tournament already has a champion: %s", getPlayerName(players, winners[0]))
+	}
+
+	// Winners of positions 2k-1 and 2k meet at position k; an odd winner out moves on with a bye
+	matches := make([]model.Match, 0, (len(winners)+1)/2)
+	for i := 0; i < len(winners); i += 2 {
+		position := i/2 + 1
+		if i+1 == len(winners) {
+			matches = append(matches, knockoutByeMatch(roundNumber, position, winners[i]))
+			continue
+		}
+		a, b := byID[winners[i]], byID[winners[i+1]]
+		if a == nil || b == nil {
+			return nil, fmt.Errorf("player not found in bracket position %d", position)
+		}
+		white, black := assignColors(a, b)
+		matches = append(matches, model.Match{
+			RoundNumber:     roundNumber,
+			TableNumber:     position,
+			PlayerA_ID:      a.ID,
+			PlayerB_ID:      b.ID,
+			WhiteID:         white.ID,
+			BlackID:         black.ID,
+			Result:          "",
+			BracketPosition: position,
+		})
+	}
+	return matches, nil
+}
+
+// seedKnockoutBracket builds round 1: seeds are placed so that seed 1 and 2 can only meet in
+// the final, and the slots left empty by a non power-of-two field become byes for the top seeds.
+func seedKnockoutBracket(players []model.Player) []model.Match {
+	seeded := make([]model.Player, len(players))
+	copy(seeded, players)
+	sort.SliceStable(seeded, func(i, j int) bool {
+		return seeded[i].Rating > seeded[j].Rating
+	})
+
+	size := 1
+	for size < len(seeded) {
+		size *= 2
+	}
+	order := bracketSeedOrder(size)
+
+	matches := make([]model.Match, 0, size/2)
+	for i := 0; i < size; i += 2 {
+		position := i/2 + 1
+		seedA, seedB := order[i], order[i+1]
+		if seedB > len(seeded) {
+			matches = append(matches, knockoutByeMatch(1, position, seeded[seedA-1].ID))
+			continue
+		}
+		a, b := seeded[seedA-1], seeded[seedB-1]
+		matches = append(matches, model.Match{
+			RoundNumber:     1,
+			TableNumber:     position,
+			PlayerA_ID:      a.ID,
+			PlayerB_ID:      b.ID,
+			WhiteID:         a.ID, // Higher seed takes White in round 1
+			BlackID:         b.ID,
+			Result:          "",
+			BracketPosition: position,
+		})
+	}
+	return matches
+}
+
+// bracketSeedOrder returns the seeds (1-based) in bracket slot order for a power-of-two size,
+// e.g. size 8 gives 1 8 4 5 2 7 3 6.
+func bracketSeedOrder(size int) []int {
+	order := []int{1}
+	for n := 2; n <= size; n *= 2 {
+		next := make([]int, 0, n)
+		for _, seed := range order {
+			next = append(next, seed, n+1-seed)
+		}
+		order = next
+	}
+	return order
+}
+
+// knockoutByeMatch moves a player forward to the next round without a game.
+func knockoutByeMatch(roundNumber int, position int, playerID string) model.Match {
+	return model.Match{
+		RoundNumber:     roundNumber,
+		TableNumber:     position,
+		PlayerA_ID:      playerID,
+		PlayerB_ID:      ByePlayerID,
+		WhiteID:         playerID,
+		BlackID:         "",
+		Result:          "",
+		BracketPosition: position,
+	}
+}
+
+// knockoutWinner returns the player who advances from a knockout match.
+func knockoutWinner(m model.Match) (string, error) {
+	switch m.Result {
+	case model.ResultAWin, model.ResultByeA, model.ResultBForfeit:
+		return m.PlayerA_ID, nil
+	case model.ResultBWin, model.ResultByeB, model.ResultAForfeit:
+		return m.PlayerB_ID, nil
+	case model.ResultDraw:
+		return "", fmt.Errorf("round %d, table %d ended in a draw: a knockout match needs a winner", m.RoundNumber, m.TableNumber)
+	case model.ResultDoubleForfeit:
+		return "", fmt.Errorf("round %d, table %d was a double forfeit: a knockout match needs a winner", m.RoundNumber, m.TableNumber)
+	}
+	return "", fmt.Errorf("round %d, table %d has no result", m.RoundNumber, m.TableNumber)
+}
+
+// GetBracket returns the knockout bracket: one slice of matches per round, in round order,
+// with each round's matches ordered by BracketPosition.
+func GetBracket(t *model.Tournament) ([][]model.Match, error) {
+	rounds, err := t.GetRounds()
+	if err != nil {
+		return nil, err
+	}
+
+	played := make([]model.Round, 0, len(rounds))
+	for _, r := range rounds {
+		if r.RoundNumber <= t.CurrentRound {
+			played = append(played, r)
+		}
+	}
+	sort.SliceStable(played, func(i, j int) bool {
+		return played[i].RoundNumber < played[j].RoundNumber
+	})
+
+	bracket := make([][]model.Match, 0, len(played))
+	for _, r := range played {
+		matches := make([]model.Match, len(r.Matches))
+		copy(matches, r.Matches)
+		sort.SliceStable(matches, func(i, j int) bool {
+			return matches[i].BracketPosition < matches[j].BracketPosition
+		})
+		bracket = append(bracket, matches)
+	}
+	return bracket, nil
+}
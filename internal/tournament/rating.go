@@ -0,0 +1,360 @@
+package tournament
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"xchess-desktop/internal/model"
+
+	"github.com/google/uuid"
+)
+
+// Elo defaults: players with fewer than DefaultProvisionalGames career games are provisional
+// and move faster (higher K); established players use the lower K.
+const (
+	DefaultProvisionalGames   = 10
+	DefaultKFactorProvisional = 40
+	DefaultKFactorEstablished = 20
+)
+
+// MaxRating is the highest rating a player may be given; 0 means unrated.
+const MaxRating = 4000
+
+// ValidateRating checks that rating lies within 0..MaxRating.
+func ValidateRating(rating int) error {
+	if rating < 0 || rating > MaxRating {
+		return fmt.Errorf("rating must be between 0 and %d, got %d", MaxRating, rating)
+	}
+	return nil
+}
+
+// SetPlayerRating assigns or corrects a tournament player's rating and logs RATING_SET.
+// Before round 1 the start ranks are re-seeded, so a rating-based first round uses the new
+// rating; afterwards start ranks stay fixed and only rating-based tie-breaks (ARO) change.
+func SetPlayerRating(t *model.Tournament, playerID string, rating int) error {
+	if err := ValidateRating(rating); err != nil {
+		return err
+	}
+
+	players, err := t.GetPlayers()
+	if err != nil {
+		return err
+	}
+	var player *model.Player
+	for i := range players {
+		if players[i].ID == playerID {
+			player = &players[i]
+			break
+		}
+	}
+	if player == nil {
+		return fmt.Errorf("player not found: %s", playerID)
+	}
+
+	oldRating := player.Rating
+	player.Rating = rating
+	if t.CurrentRound == 0 {
+		assignStartRanks(players)
+	}
+	if err := t.SetPlayers(players); err != nil {
+		return err
+	}
+	if t.CurrentRound > 0 {
+		if err := UpdateStandings(t); err != nil {
+			return err
+		}
+	}
+
+	events, _ := t.GetEvents()
+	detail := struct {
+		PlayerID  string `json:"player_id"`
+		OldRating int    `json:"old_rating"`
+		NewRating int    `json:"new_rating"`
+	}{
+		PlayerID:  playerID,
+		OldRating: oldRating,
+		NewRating: rating,
+	}
+	detailJSON, _ := json.Marshal(detail)
+	events = append(events, model.Event{
+		EventID:     uuid.New(),
+		Type:        "RATING_SET",
+		Timestamp:   time.Now(),
+		RoundNumber: t.CurrentRound,
+		TableNumber: 0, // Not applicable for player events
+		Details:     detailJSON,
+	})
+	return t.SetEvents(events)
+}
+
+// RatingChange is a player's Elo change over the rated games of a tournament.
+type RatingChange struct {
+	PlayerID     string  `json:"player_id"`
+	Name         string  `json:"name"`
+	RatingBefore int     `json:"rating_before"`
+	GamesPlayed  int     `json:"games_played"` // Career games before this tournament
+	Games        int     `json:"games"`        // Rated games in this tournament
+	Score        float64 `json:"score"`        // 1 per win, 0.5 per draw in the rated games
+	Expected     float64 `json:"expected"`
+	KFactor      int     `json:"k_factor"`
+	Change       float64 `json:"change"`
+	RatingAfter  int     `json:"rating_after"`
+}
+
+// KFactor returns the K-factor for a player with gamesPlayed career games, using the
+// tournament's thresholds when set and the defaults otherwise.
+func KFactor(t *model.Tournament, gamesPlayed int) int {
+	provisionalGames, kProvisional, kEstablished := t.ProvisionalGames, t.KFactorProvisional, t.KFactorEstablished
+	if provisionalGames == 0 {
+		provisionalGames = DefaultProvisionalGames
+	}
+	if kProvisional == 0 {
+		kProvisional = DefaultKFactorProvisional
+	}
+	if kEstablished == 0 {
+		kEstablished = DefaultKFactorEstablished
+	}
+	if gamesPlayed < provisionalGames {
+		return kProvisional
+	}
+	return kEstablished
+}
+
+// SetKFactors configures the Elo K-factors: kProvisional for players with fewer than
+// provisionalGames career games, kEstablished for everyone else.
+func SetKFactors(t *model.Tournament, provisionalGames int, kProvisional int, kEstablished int) error {
+	if provisionalGames < 0 || kProvisional <= 0 || kEstablished <= 0 {
+		return fmt.Errorf("invalid K-factors %d/%d after %d games", kProvisional, kEstablished, provisionalGames)
+	}
+	t.ProvisionalGames = provisionalGames
+	t.KFactorProvisional = kProvisional
+	t.KFactorEstablished = kEstablished
+	return nil
+}
+
+// expectedScore is the Elo expected score of a player rated own against one rated opponent.
+func expectedScore(own, opponent int) float64 {
+	return 1 / (1 + math.Pow(10, float64(opponent-own)/400))
+}
+
+// GetRatingChanges computes the Elo change of every rated player over the tournament's
+// rated games: played games (no byes, no forfeits) with a result, up to the current round,
+// against a rated opponent. K depends on the player's career GamesPlayed before the event.
+// Players without a rated game are left out. Sorted by Change desc, then Name.
+func GetRatingChanges(t *model.Tournament) ([]RatingChange, error) {
+	players, err := t.GetPlayers()
+	if err != nil {
+		return nil, err
+	}
+	rounds, err := t.GetRounds()
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make(map[string]*RatingChange, len(players))
+	for _, p := range players {
+		if p.Rating > 0 {
+			changes[p.ID] = &RatingChange{
+				PlayerID:     p.ID,
+				Name:         p.Name,
+				RatingBefore: p.Rating,
+				GamesPlayed:  p.GamesPlayed,
+				KFactor:      KFactor(t, p.GamesPlayed),
+			}
+		}
+	}
+
+	for _, r := range rounds {
+		if r.RoundNumber > t.CurrentRound {
+			continue
+		}
+		for _, m := range r.Matches {
+			scoreA := 0.0
+			switch m.Result {
+			case model.ResultAWin:
+				scoreA = 1
+			case model.ResultDraw:
+				scoreA = 0.5
+			case model.ResultBWin:
+				// Player A scored 0
+			default:
+				// Pending games, byes and forfeits are not rated
+				continue
+			}
+			a, b := changes[m.PlayerA_ID], changes[m.PlayerB_ID]
+			if a == nil || b == nil {
+				continue
+			}
+			a.Games++
+			a.Score += scoreA
+			a.Expected += expectedScore(a.RatingBefore, b.RatingBefore)
+			b.Games++
+			b.Score += 1 - scoreA
+			b.Expected += expectedScore(b.RatingBefore, a.RatingBefore)
+		}
+	}
+
+	result := []RatingChange{}
+	for _, c := range changes {
+		if c.Games == 0 {
+			continue
+		}
+		c.Change = float64(c.KFactor) * (c.Score - c.Expected)
+		c.RatingAfter = c.RatingBefore + int(math.Round(c.Change))
+		result = append(result, *c)
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].Change != result[j].Change {
+			return result[i].Change > result[j].Change
+		}
+		return result[i].Name < result[j].Name
+	})
+	return result, nil
+}
+
+// performanceDP is the FIDE rating difference (dp) for a score percentage of 50%..100%,
+// indexed by the percentage minus 50. Scores below 50% use the negated value of 100 - p.
+var performanceDP = [51]int{
+	0, 7, 14, 21, 29, 36, 43, 50, 57, 65,
+	72, 80, 87, 95, 102, 110, 117, 125, 133, 141,
+	149, 158, 166, 175, 184, 193, 202, 211, 220, 230,
+	240, 251, 262, 273, 284, 296, 309, 322, 336, 351,
+	366, 383, 401, 422, 444, 470, 501, 538, 589, 677,
+	800,
+}
+
+// ComputePerformanceRating returns a player's tournament performance rating: the average
+// rating of their rated opponents plus the FIDE dp for their score percentage in those games
+// (clamped to +/-800 at 100% and 0%). Only played games up to the current round count; byes,
+// forfeits and unrated opponents are left out. It is 0 when there is no such game.
+func ComputePerformanceRating(t *model.Tournament, playerID string) (int, error) {
+	players, err := t.GetPlayers()
+	if err != nil {
+		return 0, err
+	}
+	ratings := make(map[string]int, len(players))
+	for _, p := range players {
+		ratings[p.ID] = p.Rating
+	}
+	if _, ok := ratings[playerID]; !ok {
+		return 0, fmt.Errorf("player not found: %s", playerID)
+	}
+
+	rounds, err := t.GetRounds()
+	if err != nil {
+		return 0, err
+	}
+	games, score, opponentTotal := 0, 0.0, 0
+	for _, r := range rounds {
+		if r.RoundNumber > t.CurrentRound {
+			continue
+		}
+		for _, m := range r.Matches {
+			var opponentID string
+			switch playerID {
+			case m.PlayerA_ID:
+				opponentID = m.PlayerB_ID
+			case m.PlayerB_ID:
+				opponentID = m.PlayerA_ID
+			default:
+				continue
+			}
+			if ratings[opponentID] == 0 {
+				continue
+			}
+			switch {
+			case m.Result == model.ResultDraw:
+				score += 0.5
+			case m.Result == model.ResultAWin && m.PlayerA_ID == playerID, m.Result == model.ResultBWin && m.PlayerB_ID == playerID:
+				score++
+			case m.Result == model.ResultAWin, m.Result == model.ResultBWin:
+				// Lost the game
+			default:
+				// Pending games, byes and forfeits do not count
+				continue
+			}
+			games++
+			opponentTotal += ratings[opponentID]
+		}
+	}
+	if games == 0 {
+		return 0, nil
+	}
+
+	percentage := int(math.Round(score / float64(games) * 100))
+	dp := 0
+	if percentage >= 50 {
+		dp = performanceDP[percentage-50]
+	} else {
+		dp = -performanceDP[50-percentage]
+	}
+	average := float64(opponentTotal) / float64(games)
+	return int(math.Round(average)) + dp, nil
+}
+
+// RatingsApplied reports whether the tournament's rating changes were already applied.
+func RatingsApplied(t *model.Tournament) bool {
+	applied, _ := GetEventsByType(t, "RATINGS_APPLIED")
+	return len(applied) > 0
+}
+
+// EnsureRatingsApplicable checks that the rating changes may be applied: only once, after
+// the tournament is complete.
+func EnsureRatingsApplicable(t *model.Tournament) error {
+	if t.Status != StatusComplete {
+		return fmt.Errorf("ratings can only be applied to a complete tournament")
+	}
+	if RatingsApplied(t) {
+		return fmt.Errorf("ratings have already been applied for this tournament")
+	}
+	return nil
+}
+
+// PlayedGames counts each player's games actually played over the board (no byes, no
+// forfeits, result recorded) up to the current round; this is what GamesPlayed grows by.
+func PlayedGames(t *model.Tournament) (map[string]int, error) {
+	rounds, err := t.GetRounds()
+	if err != nil {
+		return nil, err
+	}
+	games := make(map[string]int)
+	for _, r := range rounds {
+		if r.RoundNumber > t.CurrentRound {
+			continue
+		}
+		for _, m := range r.Matches {
+			if m.Result == model.ResultAWin || m.Result == model.ResultBWin || m.Result == model.ResultDraw {
+				games[m.PlayerA_ID]++
+				games[m.PlayerB_ID]++
+			}
+		}
+	}
+	return games, nil
+}
+
+// RecordRatingsApplied logs RATINGS_APPLIED with the applied changes (see EnsureRatingsApplicable).
+func RecordRatingsApplied(t *model.Tournament, changes []RatingChange) error {
+	if err := EnsureRatingsApplicable(t); err != nil {
+		return err
+	}
+
+	events, _ := t.GetEvents()
+	detail := struct {
+		Changes []RatingChange `json:"changes"`
+	}{
+		Changes: changes,
+	}
+	detailJSON, _ := json.Marshal(detail)
+	events = append(events, model.Event{
+		EventID:     uuid.New(),
+		Type:        "RATINGS_APPLIED",
+		Timestamp:   time.Now(),
+		RoundNumber: t.CurrentRound,
+		TableNumber: 0, // Not applicable for tournament events
+		Details:     detailJSON,
+	})
+	return t.SetEvents(events)
+}
@@ -0,0 +1,216 @@
+package tournament
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"xchess-desktop/internal/model"
+
+	"github.com/google/uuid"
+)
+
+// CheckIntegrity reports violated invariants without changing anything: matches referring
+// to unknown players, players paired twice in a round, duplicate round numbers, a current
+// round that was never generated, TotalPlayers out of step with the players, and rounds
+// marked complete with results missing. Rounds after CurrentRound are expected (kept by
+// GoBackToPreviousRound, or tiebreak games) and not reported. Empty when healthy.
+func CheckIntegrity(t *model.Tournament) ([]string, error) {
+	violations := []string{}
+
+	players, err := t.GetPlayers()
+	if err != nil {
+		return nil, err
+	}
+	known := make(map[string]bool, len(players))
+	for _, p := range players {
+		known[p.ID] = true
+	}
+	if t.TotalPlayers != len(players) {
+		violations = append(violations, fmt.Sprintf("TotalPlayers is %d but the tournament has %d players", t.TotalPlayers, len(players)))
+	}
+
+	rounds, err := t.GetRounds()
+	if err != nil {
+		return nil, err
+	}
+	seenRounds := make(map[int]bool, len(rounds))
+	highest := 0
+	for _, r := range rounds {
+		if seenRounds[r.RoundNumber] {
+			violations = append(violations, fmt.Sprintf("Round %d is stored more than once", r.RoundNumber))
+		}
+		seenRounds[r.RoundNumber] = true
+		if r.RoundNumber > highest {
+			highest = r.RoundNumber
+		}
+
+		paired := make(map[string]int, len(r.Matches)*2)
+		missing := 0
+		for _, m := range r.Matches {
+			if m.Result == "" {
+				missing++
+			}
+			for _, id := range []string{m.PlayerA_ID, m.PlayerB_ID} {
+				if id == ByePlayerID {
+					continue
+				}
+				if !known[id] {
+					violations = append(violations, fmt.Sprintf("Round %d, table %d: unknown player %s", r.RoundNumber, m.TableNumber, id))
+					continue
+				}
+				if table, ok := paired[id]; ok {
+					violations = append(violations, fmt.Sprintf("Round %d: %s is paired on tables %d and %d",
+						r.RoundNumber, getPlayerName(players, id), table, m.TableNumber))
+					continue
+				}
+				paired[id] = m.TableNumber
+			}
+		}
+		if r.IsComplete && missing > 0 {
+			violations = append(violations, fmt.Sprintf("Round %d is marked complete but %d result(s) are missing", r.RoundNumber, missing))
+		}
+	}
+
+	if t.CurrentRound > highest {
+		violations = append(violations, fmt.Sprintf("CurrentRound is %d but the highest generated round is %d", t.CurrentRound, highest))
+	} else if t.CurrentRound > 0 && !seenRounds[t.CurrentRound] {
+		violations = append(violations, fmt.Sprintf("Current round %d has not been generated", t.CurrentRound))
+	}
+
+	return violations, nil
+}
+
+// RepairTournament brings the stored state back in line with the rounds after the JSON blobs
+// drifted apart (e.g. after a crash): it re-derives TotalPlayers, fixes match round numbers,
+// recalculates each round's IsComplete, renumbers the tables of rounds with duplicate or
+// missing table numbers and rebuilds every player aggregate from the rounds.
+// It returns a description of each repair made; a second run on the result reports none.
+func RepairTournament(t *model.Tournament) ([]string, error) {
+	repairs := []string{}
+
+	players, err := t.GetPlayers()
+	if err != nil {
+		return nil, err
+	}
+	if t.TotalPlayers != len(players) {
+		repairs = append(repairs, fmt.Sprintf("TotalPlayers %d -> %d", t.TotalPlayers, len(players)))
+		t.TotalPlayers = len(players)
+	}
+
+	rounds, err := t.GetRounds()
+	if err != nil {
+		return nil, err
+	}
+	roundsChanged := false
+	for r := range rounds {
+		round := &rounds[r]
+
+		for m := range round.Matches {
+			if round.Matches[m].RoundNumber != round.RoundNumber {
+				repairs = append(repairs, fmt.Sprintf("Round %d, table %d: match round number %d -> %d",
+					round.RoundNumber, round.Matches[m].TableNumber, round.Matches[m].RoundNumber, round.RoundNumber))
+				round.Matches[m].RoundNumber = round.RoundNumber
+				roundsChanged = true
+			}
+		}
+
+		tables := make(map[int]bool, len(round.Matches))
+		duplicates := false
+		for _, m := range round.Matches {
+			if m.TableNumber < 1 || tables[m.TableNumber] {
+				duplicates = true
+				break
+			}
+			tables[m.TableNumber] = true
+		}
+		if duplicates {
+			// Keep the existing order; matches sharing a number stay in their stored order
+			sort.SliceStable(round.Matches, func(i, j int) bool {
+				return round.Matches[i].TableNumber < round.Matches[j].TableNumber
+			})
+			for m := range round.Matches {
+				round.Matches[m].TableNumber = m + 1
+			}
+			repairs = append(repairs, fmt.Sprintf("Round %d: tables renumbered 1-%d", round.RoundNumber, len(round.Matches)))
+			roundsChanged = true
+		}
+
+		allComplete := true
+		for _, m := range round.Matches {
+			if m.Result == "" {
+				allComplete = false
+				break
+			}
+		}
+		if round.IsComplete != allComplete {
+			repairs = append(repairs, fmt.Sprintf("Round %d: IsComplete %t -> %t", round.RoundNumber, round.IsComplete, allComplete))
+			round.IsComplete = allComplete
+			roundsChanged = true
+		}
+	}
+	if roundsChanged {
+		if err := t.SetRounds(rounds); err != nil {
+			return nil, err
+		}
+	}
+
+	// Rebuild the aggregates and report the players whose stored values were off
+	if err := RecomputePlayersFromRounds(t); err != nil {
+		return nil, err
+	}
+	if err := UpdateStandings(t); err != nil {
+		return nil, err
+	}
+	recomputed, err := t.GetPlayers()
+	if err != nil {
+		return nil, err
+	}
+	before := make(map[string]model.Player, len(players))
+	for _, p := range players {
+		before[p.ID] = p
+	}
+	for _, p := range recomputed {
+		old := before[p.ID]
+		if old.Score != p.Score {
+			repairs = append(repairs, fmt.Sprintf("%s: score %.1f -> %.1f", p.Name, old.Score, p.Score))
+		}
+		if old.ColorHistory != p.ColorHistory {
+			repairs = append(repairs, fmt.Sprintf("%s: colors %q -> %q", p.Name, old.ColorHistory, p.ColorHistory))
+		}
+		if old.HasBye != p.HasBye {
+			repairs = append(repairs, fmt.Sprintf("%s: bye %t -> %t", p.Name, old.HasBye, p.HasBye))
+		}
+		if len(old.OpponentIDs) != len(p.OpponentIDs) {
+			repairs = append(repairs, fmt.Sprintf("%s: opponents %d -> %d", p.Name, len(old.OpponentIDs), len(p.OpponentIDs)))
+		}
+	}
+
+	if len(repairs) == 0 {
+		return repairs, nil
+	}
+
+	// Undone actions may refer to tables that were renumbered
+	clearRedo(t)
+
+	events, _ := t.GetEvents()
+	detail := struct {
+		Repairs []string `json:"repairs"`
+	}{
+		Repairs: repairs,
+	}
+	detailJSON, _ := json.Marshal(detail)
+	events = append(events, model.Event{
+		EventID:     uuid.New(),
+		Type:        "TOURNAMENT_REPAIRED",
+		Timestamp:   time.Now(),
+		RoundNumber: t.CurrentRound,
+		TableNumber: 0, // Not applicable for tournament events
+		Details:     detailJSON,
+	})
+	if err := t.SetEvents(events); err != nil {
+		return nil, err
+	}
+	return repairs, nil
+}
@@ -0,0 +1,129 @@
+package tournament
+
+import (
+	"fmt"
+	"sort"
+
+	"xchess-desktop/internal/model"
+)
+
+// PairingSystemRoundRobin is the Tournament.PairingSystem value for all-play-all events.
+const PairingSystemRoundRobin = "ROUND_ROBIN"
+
+// RoundRobinAdapter pairs an all-play-all event from Berger tables: every player meets every
+// other player once, in RoundRobinRounds rounds. Players are numbered by StartRank; with an
+// odd field the player drawn against the missing number gets the bye.
+type RoundRobinAdapter struct{}
+
+func init() {
+	RegisterEngine(PairingSystemRoundRobin, func() PairingEngine { return RoundRobinAdapter{} })
+}
+
+// RoundRobinRounds returns the number of rounds a round robin of playerCount players takes
+// (doubled for double-round events).
+func RoundRobinRounds(t *model.Tournament, playerCount int) int {
+	rounds := playerCount - 1
+	if playerCount%2 == 1 {
+		rounds = playerCount
+	}
+	if t.DoubleRound {
+		rounds *= 2
+	}
+	return rounds
+}
+
+// GeneratePairings implements PairingEngine for round robin events. The schedule is built
+// from every player of the tournament, so it does not shift when someone withdraws: the
+// opponent of a withdrawn player gets a bye instead.
+func (a RoundRobinAdapter) GeneratePairings(t *model.Tournament, players []model.Player, roundNumber int) ([]model.Match, error) {
+	field, err := t.GetPlayers()
+	if err != nil {
+		return nil, err
+	}
+	if len(field) < 2 {
+		return nil, fmt.Errorf("round robin needs at least 2 players")
+	}
+
+	// Double-round events repeat each round with colors reversed; the engine pairs the odd rounds
+	cycle := roundNumber
+	if t.DoubleRound {
+		cycle = (roundNumber + 1) / 2
+	}
+
+	// Berger numbering: start rank order, plus an empty slot for an odd field
+	ranks := startRanks(field)
+	sort.SliceStable(field, func(i, j int) bool {
+		return ranks[field[i].ID] < ranks[field[j].ID]
+	})
+	ids := make([]string, 0, len(field)+1)
+	for _, p := range field {
+		ids = append(ids, p.ID)
+	}
+	if len(ids)%2 == 1 {
+		ids = append(ids, ByePlayerID)
+	}
+	n := len(ids)
+	if cycle < 1 || cycle > n-1 {
+		return nil, fmt.Errorf("round robin of %d players has no round %d", len(field), roundNumber)
+	}
+
+	active := make(map[string]bool, len(players))
+	for _, p := range players {
+		active[p.ID] = true
+	}
+
+	// The last number stays fixed; the others rotate by n/2 places per round, so players
+	// switch between the white (top) and black (bottom) half from one round to the next
+	r := cycle - 1
+	shift := r * n / 2
+	slot := func(i int) string {
+		return ids[(shift+i)%(n-1)]
+	}
+
+	matches := make([]model.Match, 0, n/2)
+	for k := 0; k < n/2; k++ {
+		white, black := slot(k), ids[n-1]
+		if k == 0 {
+			if r%2 == 1 {
+				white, black = black, white
+			}
+		} else {
+			black = slot(n - 1 - k)
+		}
+
+		// Absent (withdrawn) players and the empty slot turn the game into a bye
+		if !active[white] || white == ByePlayerID {
+			white = ByePlayerID
+		}
+		if !active[black] || black == ByePlayerID {
+			black = ByePlayerID
+		}
+		switch {
+		case white == ByePlayerID && black == ByePlayerID:
+			continue
+		case black == ByePlayerID:
+			matches = append(matches, model.Match{RoundNumber: roundNumber, PlayerA_ID: white, PlayerB_ID: ByePlayerID, WhiteID: white})
+			continue
+		case white == ByePlayerID:
+			matches = append(matches, model.Match{RoundNumber: roundNumber, PlayerA_ID: black, PlayerB_ID: ByePlayerID, WhiteID: black})
+			continue
+		}
+		matches = append(matches, model.Match{
+			RoundNumber: roundNumber,
+			PlayerA_ID:  white,
+			PlayerB_ID:  black,
+			WhiteID:     white,
+			BlackID:     black,
+			Result:      "",
+		})
+	}
+
+	// Games first, byes last
+	sort.SliceStable(matches, func(i, j int) bool {
+		return !isBye(matches[i]) && isBye(matches[j])
+	})
+	for i := range matches {
+		matches[i].TableNumber = i + 1
+	}
+	return matches, nil
+}
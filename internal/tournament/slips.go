@@ -0,0 +1,254 @@
+package tournament
+
+import (
+	"fmt"
+	"sort"
+
+	"xchess-desktop/internal/model"
+
+	"github.com/johnfercher/maroto/v2"
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/config"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/border"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// slipsPerRow is how many player slips are laid out side by side on a page.
+const slipsPerRow = 2
+
+// playerSlip is the content of one player's pairing card.
+type playerSlip struct {
+	Name     string
+	Table    int
+	Color    string // "White" or "Black"; empty for a bye
+	Opponent string // Empty for a bye
+	Bye      bool
+}
+
+// roundSlips returns one entry per paired player of a round (bye recipients included),
+// in alphabetical order.
+func roundSlips(t *model.Tournament, roundNumber int) ([]playerSlip, error) {
+	players, err := t.GetPlayers()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get players: %w", err)
+	}
+
+	rounds, err := t.GetRounds()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rounds: %w", err)
+	}
+
+	var targetRound *model.Round
+	for i := range rounds {
+		if rounds[i].RoundNumber == roundNumber {
+			targetRound = &rounds[i]
+			break
+		}
+	}
+	if targetRound == nil {
+		return nil, fmt.Errorf("round %d not found", roundNumber)
+	}
+
+	slips := []playerSlip{}
+	for _, m := range targetRound.Matches {
+		if isBye(m) {
+			slips = append(slips, playerSlip{
+				Name:  getPlayerName(players, byeRecipient(m)),
+				Table: m.TableNumber,
+				Bye:   true,
+			})
+			continue
+		}
+		for _, side := range [][2]string{{m.PlayerA_ID, m.PlayerB_ID}, {m.PlayerB_ID, m.PlayerA_ID}} {
+			color := "Black"
+			if m.WhiteID == side[0] {
+				color = "White"
+			}
+			slips = append(slips, playerSlip{
+				Name:     getPlayerName(players, side[0]),
+				Table:    m.TableNumber,
+				Color:    color,
+				Opponent: getPlayerName(players, side[1]),
+			})
+		}
+	}
+	if len(slips) == 0 {
+		return nil, fmt.Errorf("no pairings found in round %d", roundNumber)
+	}
+	sort.SliceStable(slips, func(i, j int) bool {
+		return slips[i].Name < slips[j].Name
+	})
+	return slips, nil
+}
+
+// ExportPlayerSlipsToPDF generates one compact card per paired player of a round with their
+// name, table, color and opponent, several cards per page in alphabetical order so they are
+// easy to hand out. Players with a bye get a "BYE this round" card.
+func ExportPlayerSlipsToPDF(t *model.Tournament, roundNumber int) ([]byte, error) {
+	slips, err := roundSlips(t, roundNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := config.NewBuilder().
+		WithPageNumber().
+		Build()
+
+	m := maroto.New(cfg)
+
+	cardStyle := &props.Cell{
+		BorderType:      border.Full,
+		BorderThickness: 0.3,
+	}
+	labelProps := props.Text{
+		Left:  3,
+		Align: align.Left,
+		Size:  9,
+	}
+
+	width := 12 / slipsPerRow
+	for start := 0; start < len(slips); start += slipsPerRow {
+		cols := []core.Col{}
+		for i := start; i < start+slipsPerRow; i++ {
+			if i >= len(slips) {
+				cols = append(cols, col.New(width))
+				continue
+			}
+			slip := slips[i]
+
+			name := labelProps
+			name.Top = 3
+			name.Style = fontstyle.Bold
+			name.Size = 12
+			round := labelProps
+			round.Top = 10
+			card := col.New(width).WithStyle(cardStyle).Add(
+				text.New(slip.Name, name),
+				text.New(fmt.Sprintf("%s - Round %d", t.Title, roundNumber), round),
+			)
+
+			if slip.Bye {
+				bye := labelProps
+				bye.Top = 18
+				bye.Style = fontstyle.Bold
+				bye.Size = 11
+				card = card.Add(text.New("BYE this round", bye))
+			} else {
+				table := labelProps
+				table.Top = 16
+				table.Style = fontstyle.Bold
+				table.Size = 11
+				color := labelProps
+				color.Top = 22
+				opponent := labelProps
+				opponent.Top = 27
+				card = card.Add(
+					text.New(fmt.Sprintf("Table %d", slip.Table), table),
+					text.New(fmt.Sprintf("Color: %s", slip.Color), color),
+					text.New(fmt.Sprintf("Opponent: %s", slip.Opponent), opponent),
+				)
+			}
+			cols = append(cols, card)
+		}
+		m.AddRows(row.New(34).Add(cols...))
+		// Spacing between rows of cards for cutting
+		m.AddRows(row.New(4))
+	}
+
+	// Generate PDF
+	document, err := m.Generate()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate PDF: %w", err)
+	}
+
+	return document.GetBytes(), nil
+}
+
+// ExportAlphabeticalPairingsToPDF generates the pairings of a round as one line per player in
+// alphabetical order (name, table, color, opponent), so players at large events can find
+// their own name quickly. Bye recipients are listed at their alphabetical position.
+func ExportAlphabeticalPairingsToPDF(t *model.Tournament, roundNumber int) ([]byte, error) {
+	slips, err := roundSlips(t, roundNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := config.NewBuilder().
+		WithPageNumber().
+		Build()
+
+	m := maroto.New(cfg)
+
+	// Add tournament title
+	m.AddRows(
+		row.New(10).Add(
+			col.New(12).Add(
+				text.New(t.Title, props.Text{
+					Top:   2,
+					Style: fontstyle.Bold,
+					Align: align.Center,
+					Size:  18,
+				}),
+			),
+		),
+	)
+
+	m.AddRows(
+		row.New(12).Add(
+			col.New(12).Add(
+				text.New(fmt.Sprintf("Round %d - Pairings by Name", roundNumber), props.Text{
+					Top:   3,
+					Style: fontstyle.Bold,
+					Align: align.Center,
+					Size:  14,
+				}),
+			),
+		),
+	)
+
+	headerProps := props.Text{
+		Top:   2,
+		Style: fontstyle.Bold,
+		Align: align.Center,
+		Size:  10,
+	}
+	m.AddRows(row.New(10).Add(
+		col.New(5).Add(text.New("Name", headerProps)),
+		col.New(2).Add(text.New("Table", headerProps)),
+		col.New(1).Add(text.New("Color", headerProps)),
+		col.New(4).Add(text.New("Opponent", headerProps)),
+	))
+
+	cellProps := props.Text{
+		Top:   1,
+		Align: align.Center,
+		Size:  9,
+	}
+	nameProps := cellProps
+	nameProps.Align = align.Left
+	for _, slip := range slips {
+		color, opponent := slip.Color, slip.Opponent
+		if slip.Bye {
+			color, opponent = "-", "BYE"
+		}
+		m.AddRows(row.New(7).Add(
+			col.New(5).Add(text.New(slip.Name, nameProps)),
+			col.New(2).Add(text.New(fmt.Sprintf("%d", slip.Table), cellProps)),
+			col.New(1).Add(text.New(color, cellProps)),
+			col.New(4).Add(text.New(opponent, nameProps)),
+		))
+	}
+
+	// Generate PDF
+	document, err := m.Generate()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate PDF: %w", err)
+	}
+
+	return document.GetBytes(), nil
+}
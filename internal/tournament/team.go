@@ -0,0 +1,358 @@
+package tournament
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"xchess-desktop/internal/model"
+)
+
+// PairingSystemTeam is the Tournament.PairingSystem value for team Swiss events.
+const PairingSystemTeam = "TEAM"
+
+// Team match points: a team wins a match by scoring more board points than its opponent.
+const (
+	teamMatchPointsWin  = 2.0
+	teamMatchPointsDraw = 1.0
+)
+
+// TeamMatch groups the individual board pairings between two teams in a round.
+type TeamMatch struct {
+	RoundNumber  int           `json:"round_number"`
+	TeamA        string        `json:"team_a"`
+	TeamB        string        `json:"team_b"` // "BYE" for a team bye
+	Boards       []model.Match `json:"boards"` // In board order
+	BoardPointsA float64       `json:"board_points_a"`
+	BoardPointsB float64       `json:"board_points_b"`
+	IsComplete   bool          `json:"is_complete"` // Every board has a result
+}
+
+// TeamStanding is a team's aggregate result.
+type TeamStanding struct {
+	Team          string  `json:"team"`
+	Players       int     `json:"players"`
+	MatchesPlayed int     `json:"matches_played"`
+	MatchPoints   float64 `json:"match_points"` // 2 per team match won, 1 per team match drawn
+	BoardPoints   float64 `json:"board_points"` // Sum of the team members' individual scores
+}
+
+// TeamAdapter pairs teams instead of individual players (see GenerateTeamPairings).
+type TeamAdapter struct{}
+
+func init() {
+	RegisterEngine(PairingSystemTeam, func() PairingEngine { return TeamAdapter{} })
+}
+
+// GeneratePairings implements PairingEngine for team events.
+func (a TeamAdapter) GeneratePairings(t *model.Tournament, players []model.Player, roundNumber int) ([]model.Match, error) {
+	return GenerateTeamPairings(t, players, roundNumber)
+}
+
+// SetPlayerTeam assigns a player to a team (an empty team removes the assignment).
+func SetPlayerTeam(t *model.Tournament, playerID string, team string) error {
+	players, err := t.GetPlayers()
+	if err != nil {
+		return err
+	}
+	for i := range players {
+		if players[i].ID == playerID {
+			players[i].Team = strings.TrimSpace(team)
+			return t.SetPlayers(players)
+		}
+	}
+	return fmt.Errorf("player not found: %s", playerID)
+}
+
+// teamRosters groups players by team, each roster in board order: Rating desc, then Name.
+func teamRosters(players []model.Player) (map[string][]model.Player, error) {
+	rosters := make(map[string][]model.Player)
+	for _, p := range players {
+		if p.Team == "" {
+			return nil, fmt.Errorf("player %s has no team", p.Name)
+		}
+		rosters[p.Team] = append(rosters[p.Team], p)
+	}
+	for _, roster := range rosters {
+		sort.SliceStable(roster, func(i, j int) bool {
+			if roster[i].Rating != roster[j].Rating {
+				return roster[i].Rating > roster[j].Rating
+			}
+			return roster[i].Name < roster[j].Name
+		})
+	}
+	return rosters, nil
+}
+
+// GenerateTeamPairings pairs teams by cumulative match points (then board points, then name),
+// avoiding teams that already met where possible, and lays out the boards in roster order:
+// board n of one team plays board n of the other. Team A has White on odd boards. Players beyond
+// the smaller roster are not paired; with an odd number of teams the lowest team that has not
+// had a bye yet gets it.
+func GenerateTeamPairings(t *model.Tournament, players []model.Player, roundNumber int) ([]model.Match, error) {
+	rosters, err := teamRosters(players)
+	if err != nil {
+		return nil, err
+	}
+	if len(rosters) < 2 {
+		return nil, fmt.Errorf("team pairing needs at least 2 teams")
+	}
+
+	standings, err := GetTeamStandings(t)
+	if err != nil {
+		return nil, err
+	}
+	byTeam := make(map[string]TeamStanding, len(standings))
+	for _, s := range standings {
+		byTeam[s.Team] = s
+	}
+
+	teams := make([]string, 0, len(rosters))
+	for team := range rosters {
+		teams = append(teams, team)
+	}
+	sort.SliceStable(teams, func(i, j int) bool {
+		a, b := byTeam[teams[i]], byTeam[teams[j]]
+		if a.MatchPoints != b.MatchPoints {
+			return a.MatchPoints > b.MatchPoints
+		}
+		if a.BoardPoints != b.BoardPoints {
+			return a.BoardPoints > b.BoardPoints
+		}
+		return teams[i] < teams[j]
+	})
+
+	// Teams that already met, and teams that already had a bye
+	met := make(map[[2]string]bool)
+	hadBye := make(map[string]bool)
+	previous, err := GetTeamMatches(t, 0)
+	if err != nil {
+		return nil, err
+	}
+	for _, tm := range previous {
+		if tm.TeamB == ByePlayerID {
+			hadBye[tm.TeamA] = true
+			continue
+		}
+		met[[2]string{tm.TeamA, tm.TeamB}] = true
+		met[[2]string{tm.TeamB, tm.TeamA}] = true
+	}
+
+	// The lowest team without a bye gets the bye (the lowest team once every team had one)
+	var byeTeam string
+	if len(teams)%2 == 1 {
+		bye := len(teams) - 1
+		for i := len(teams) - 1; i >= 0; i-- {
+			if !hadBye[teams[i]] {
+				bye = i
+				break
+			}
+		}
+		byeTeam = teams[bye]
+		teams = append(teams[:bye:bye], teams[bye+1:]...)
+	}
+
+	// Top-down: each team meets the highest team it has not met yet, or the next one if all were met
+	paired := make(map[string]bool, len(teams))
+	var pairs [][2]string
+	for i, a := range teams {
+		if paired[a] {
+			continue
+		}
+		opponent := ""
+		for _, b := range teams[i+1:] {
+			if paired[b] {
+				continue
+			}
+			if opponent == "" {
+				opponent = b
+			}
+			if !met[[2]string{a, b}] {
+				opponent = b
+				break
+			}
+		}
+		paired[a], paired[opponent] = true, true
+		pairs = append(pairs, [2]string{a, opponent})
+	}
+
+	matches := []model.Match{}
+	table := 1
+	for _, pair := range pairs {
+		rosterA, rosterB := rosters[pair[0]], rosters[pair[1]]
+		boards := len(rosterA)
+		if len(rosterB) < boards {
+			boards = len(rosterB)
+		}
+		for board := 0; board < boards; board++ {
+			a, b := rosterA[board].ID, rosterB[board].ID
+			white, black := a, b
+			if board%2 == 1 {
+				white, black = b, a
+			}
+			matches = append(matches, model.Match{
+				RoundNumber: roundNumber,
+				TableNumber: table,
+				PlayerA_ID:  a,
+				PlayerB_ID:  b,
+				WhiteID:     white,
+				BlackID:     black,
+				Result:      "",
+			})
+			table++
+		}
+	}
+	if byeTeam != "" {
+		for _, p := range rosters[byeTeam] {
+			matches = append(matches, model.Match{
+				RoundNumber: roundNumber,
+				TableNumber: table,
+				PlayerA_ID:  p.ID,
+				PlayerB_ID:  ByePlayerID,
+				WhiteID:     p.ID,
+				BlackID:     "",
+				Result:      "",
+			})
+			table++
+		}
+	}
+
+	return matches, nil
+}
+
+// GetTeamMatches groups each round's boards into team matches. A roundNumber of 0 returns the
+// team matches of every round up to the current one. Boards between players of the same team,
+// or involving a player without a team, are not part of any team match.
+func GetTeamMatches(t *model.Tournament, roundNumber int) ([]TeamMatch, error) {
+	players, err := t.GetPlayers()
+	if err != nil {
+		return nil, err
+	}
+	teamOf := make(map[string]string, len(players))
+	for _, p := range players {
+		teamOf[p.ID] = p.Team
+	}
+
+	rounds, err := t.GetRounds()
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(rounds, func(i, j int) bool {
+		return rounds[i].RoundNumber < rounds[j].RoundNumber
+	})
+
+	result := []TeamMatch{}
+	for _, r := range rounds {
+		if r.RoundNumber > t.CurrentRound || (roundNumber != 0 && r.RoundNumber != roundNumber) {
+			continue
+		}
+
+		// Keyed by the team pair as first seen, so board order follows the tables
+		index := make(map[[2]string]int)
+		for _, m := range r.Matches {
+			teamA, teamB := teamOf[m.PlayerA_ID], teamOf[m.PlayerB_ID]
+			scoreA, scoreB := m.ScoreA, m.ScoreB
+			if isBye(m) {
+				teamA, teamB = teamOf[byeRecipient(m)], ByePlayerID
+				if m.PlayerA_ID == ByePlayerID {
+					scoreA, scoreB = m.ScoreB, m.ScoreA
+				}
+			}
+			if teamA == "" || teamB == "" || teamA == teamB {
+				continue
+			}
+
+			i, ok := index[[2]string{teamA, teamB}]
+			if !ok {
+				if j, reversed := index[[2]string{teamB, teamA}]; reversed {
+					i, ok = j, true
+					teamA, teamB = teamB, teamA
+					scoreA, scoreB = scoreB, scoreA
+				}
+			}
+			if !ok {
+				i = len(result)
+				index[[2]string{teamA, teamB}] = i
+				result = append(result, TeamMatch{RoundNumber: r.RoundNumber, TeamA: teamA, TeamB: teamB, IsComplete: true})
+			}
+
+			tm := &result[i]
+			tm.Boards = append(tm.Boards, m)
+			tm.BoardPointsA += scoreA
+			tm.BoardPointsB += scoreB
+			if m.Result == "" {
+				tm.IsComplete = false
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// GetTeamStandings returns the teams sorted by match points, then board points, then name.
+// Match points come from completed team matches (a team bye counts as a win); board points
+// are the sum of the team members' individual scores.
+func GetTeamStandings(t *model.Tournament) ([]TeamStanding, error) {
+	players, err := t.GetPlayers()
+	if err != nil {
+		return nil, err
+	}
+
+	byTeam := make(map[string]*TeamStanding)
+	standing := func(team string) *TeamStanding {
+		if byTeam[team] == nil {
+			byTeam[team] = &TeamStanding{Team: team}
+		}
+		return byTeam[team]
+	}
+	for _, p := range players {
+		if p.Team == "" {
+			continue
+		}
+		s := standing(p.Team)
+		s.Players++
+		s.BoardPoints += p.Score
+	}
+
+	teamMatches, err := GetTeamMatches(t, 0)
+	if err != nil {
+		return nil, err
+	}
+	for _, tm := range teamMatches {
+		if !tm.IsComplete {
+			continue
+		}
+		a := standing(tm.TeamA)
+		a.MatchesPlayed++
+		if tm.TeamB == ByePlayerID {
+			a.MatchPoints += teamMatchPointsWin
+			continue
+		}
+		b := standing(tm.TeamB)
+		b.MatchesPlayed++
+		switch {
+		case tm.BoardPointsA > tm.BoardPointsB:
+			a.MatchPoints += teamMatchPointsWin
+		case tm.BoardPointsA < tm.BoardPointsB:
+			b.MatchPoints += teamMatchPointsWin
+		default:
+			a.MatchPoints += teamMatchPointsDraw
+			b.MatchPoints += teamMatchPointsDraw
+		}
+	}
+
+	result := make([]TeamStanding, 0, len(byTeam))
+	for _, s := range byTeam {
+		result = append(result, *s)
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].MatchPoints != result[j].MatchPoints {
+			return result[i].MatchPoints > result[j].MatchPoints
+		}
+		if result[i].BoardPoints != result[j].BoardPoints {
+			return result[i].BoardPoints > result[j].BoardPoints
+		}
+		return result[i].Team < result[j].Team
+	})
+	return result, nil
+}
@@ -0,0 +1,52 @@
+package tournament
+
+import (
+	"fmt"
+	"testing"
+
+	"xchess-desktop/internal/model"
+)
+
+func TestTeamByeNotRepeated(t *testing.T) {
+	tour := &model.Tournament{PairingSystem: PairingSystemTeam}
+	var players []model.Player
+	for _, team := range []string{"Alpha", "Bravo", "Charlie"} {
+		for board := 1; board <= 2; board++ {
+			players = append(players, model.Player{
+				ID:   fmt.Sprintf("%s%d", team, board),
+				Name: fmt.Sprintf("%s %d", team, board),
+				Team: team,
+			})
+		}
+	}
+	if err := InitializeTournament(tour, "Team Open", "Test event", players); err != nil {
+		t.Fatal(err)
+	}
+	tour.RoundsTotal = 3
+
+	byes := map[string]int{}
+	for round := 1; round <= 3; round++ {
+		if err := AdvanceToNextRound(tour, TeamAdapter{}); err != nil {
+			t.Fatal(err)
+		}
+		byeTeams := map[string]bool{}
+		for _, m := range currentMatches(t, tour) {
+			result := model.ResultAWin
+			if m.PlayerB_ID == ByePlayerID {
+				result = model.ResultByeA
+				byeTeams[playerByID(t, tour, m.PlayerA_ID).Team] = true
+			}
+			if err := RecordMatchResult(tour, round, m.TableNumber, result); err != nil {
+				t.Fatal(err)
+			}
+		}
+		for team := range byeTeams {
+			byes[team]++
+		}
+	}
+	for _, team := range []string{"Alpha", "Bravo", "Charlie"} {
+		if byes[team] != 1 {
+			t.Errorf("team %s had %d byes in 3 rounds, want 1", team, byes[team])
+		}
+	}
+}
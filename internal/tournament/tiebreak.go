@@ -0,0 +1,134 @@
+package tournament
+
+import (
+	"fmt"
+
+	"xchess-desktop/internal/model"
+)
+
+// TieBreak names a tie-break that can be listed in Tournament.TieBreakOrder.
+type TieBreak string
+
+const (
+	TieBreakHeadToHead   TieBreak = "HEAD_TO_HEAD"
+	TieBreakBuchholz     TieBreak = "BUCHHOLZ"
+	TieBreakBuchholzCut1 TieBreak = "BUCHHOLZ_CUT1"
+	TieBreakBuchholzCut2 TieBreak = "BUCHHOLZ_CUT2"
+	TieBreakSonneborn    TieBreak = "SONNEBORN"
+	TieBreakProgressive  TieBreak = "PROGRESSIVE"
+	TieBreakARO          TieBreak = "ARO"
+)
+
+// TieBreakFunc compares two players tied on score.
+// It returns a positive value when a ranks above b, negative when below and 0 when still tied.
+type TieBreakFunc func(a, b *model.Player) float64
+
+// tieBreakRegistry maps tie-break names to their comparison functions.
+// GetStandings only iterates over it, so new tie-breaks just need to be registered here.
+var tieBreakRegistry = map[TieBreak]TieBreakFunc{
+	TieBreakHeadToHead: func(a, b *model.Player) float64 {
+		// Only between tied players who actually faced each other.
+		// Points are summed over all their games, so a draw or split results fall through.
+		if aPts, ok := a.HeadToHeadResults[b.ID]; ok {
+			if bPts, ok := b.HeadToHeadResults[a.ID]; ok {
+				return aPts - bPts
+			}
+		}
+		return 0
+	},
+	TieBreakBuchholz: func(a, b *model.Player) float64 {
+		return a.Buchholz - b.Buchholz
+	},
+	TieBreakBuchholzCut1: func(a, b *model.Player) float64 {
+		return a.BuchholzCut1 - b.BuchholzCut1
+	},
+	TieBreakBuchholzCut2: func(a, b *model.Player) float64 {
+		return a.BuchholzCut2 - b.BuchholzCut2
+	},
+	TieBreakSonneborn: func(a, b *model.Player) float64 {
+		return a.SonnebornBerger - b.SonnebornBerger
+	},
+	TieBreakProgressive: func(a, b *model.Player) float64 {
+		return a.ProgressiveScore - b.ProgressiveScore
+	},
+	// Players without rated opponents have an ARO of 0, so ARO cannot separate two such players
+	TieBreakARO: func(a, b *model.Player) float64 {
+		return a.AvgOpponentRating - b.AvgOpponentRating
+	},
+}
+
+// RegisterTieBreak adds or replaces a tie-break in the registry.
+func RegisterTieBreak(name TieBreak, compare TieBreakFunc) {
+	tieBreakRegistry[name] = compare
+}
+
+// DefaultTieBreakOrder is used when the tournament has no TieBreakOrder configured.
+func DefaultTieBreakOrder() []string {
+	return []string{string(TieBreakHeadToHead), string(TieBreakBuchholz), string(TieBreakProgressive)}
+}
+
+// tieBreakOrder returns the tournament's TieBreakOrder, or the default when none is configured.
+func tieBreakOrder(t *model.Tournament) []string {
+	if len(t.TieBreakOrder) == 0 {
+		return DefaultTieBreakOrder()
+	}
+	return t.TieBreakOrder
+}
+
+// SetTieBreakOrder validates the tie-break names and stores them on the tournament.
+// An empty order restores the default.
+func SetTieBreakOrder(t *model.Tournament, order []string) error {
+	seen := make(map[string]bool, len(order))
+	for _, name := range order {
+		if _, ok := tieBreakRegistry[TieBreak(name)]; !ok {
+			return fmt.Errorf("unknown tie-break: %s", name)
+		}
+		if seen[name] {
+			return fmt.Errorf("duplicate tie-break: %s", name)
+		}
+		seen[name] = true
+	}
+	t.TieBreakOrder = order
+	return nil
+}
+
+// tieBreakLabels are the short column headers used when printing tie-break values.
+// HEAD_TO_HEAD is pairwise and has no per-player value, so it is not listed.
+var tieBreakLabels = map[TieBreak]string{
+	TieBreakBuchholz:     "Buchholz",
+	TieBreakBuchholzCut1: "BH Cut1",
+	TieBreakBuchholzCut2: "BH Cut2",
+	TieBreakSonneborn:    "SB",
+	TieBreakProgressive:  "Progressive",
+	TieBreakARO:          "ARO",
+}
+
+// tieBreakValue returns the player's value for a tie-break, if it has one.
+func tieBreakValue(p model.Player, name TieBreak) (float64, bool) {
+	switch name {
+	case TieBreakBuchholz:
+		return p.Buchholz, true
+	case TieBreakBuchholzCut1:
+		return p.BuchholzCut1, true
+	case TieBreakBuchholzCut2:
+		return p.BuchholzCut2, true
+	case TieBreakSonneborn:
+		return p.SonnebornBerger, true
+	case TieBreakProgressive:
+		return p.ProgressiveScore, true
+	case TieBreakARO:
+		return p.AvgOpponentRating, true
+	}
+	return 0, false
+}
+
+// printableTieBreaks returns the tournament's tie-breaks, in order, that have a per-player value.
+func printableTieBreaks(t *model.Tournament) []TieBreak {
+	var result []TieBreak
+	for _, name := range tieBreakOrder(t) {
+		if _, ok := tieBreakLabels[TieBreak(name)]; ok {
+			result = append(result, TieBreak(name))
+		}
+	}
+	return result
+}
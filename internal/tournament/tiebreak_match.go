@@ -0,0 +1,213 @@
+package tournament
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"xchess-desktop/internal/model"
+
+	"github.com/google/uuid"
+)
+
+// tiebreakWinner returns the winner and loser of a decided tiebreak match. The game is an
+// armageddon: a draw counts as a win for Black (draw odds).
+func tiebreakWinner(m model.Match) (string, string, bool) {
+	switch m.Result {
+	case model.ResultAWin, model.ResultBForfeit:
+		return m.PlayerA_ID, m.PlayerB_ID, true
+	case model.ResultBWin, model.ResultAForfeit:
+		return m.PlayerB_ID, m.PlayerA_ID, true
+	case model.ResultDraw:
+		return m.BlackID, m.WhiteID, true
+	}
+	return "", "", false
+}
+
+// tiebreakWinners lists {winner, loser} for every decided tiebreak match, in the order the
+// matches were scheduled.
+func tiebreakWinners(t *model.Tournament) [][2]string {
+	matches, err := t.GetTiebreakMatches()
+	if err != nil {
+		return nil
+	}
+	var winners [][2]string
+	for _, m := range matches {
+		if winner, loser, ok := tiebreakWinner(m); ok {
+			winners = append(winners, [2]string{winner, loser})
+		}
+	}
+	return winners
+}
+
+// applyTiebreakWinners reorders sorted standings after the fact: when the loser of a decided
+// tiebreak match is placed above its winner and both are level on points, the two swap places.
+// Keeping this out of the sort comparator keeps the comparator a strict weak ordering.
+func applyTiebreakWinners(standings []model.Player, winners [][2]string) {
+	for _, w := range winners {
+		winner, loser := -1, -1
+		for i, p := range standings {
+			switch p.ID {
+			case w[0]:
+				winner = i
+			case w[1]:
+				loser = i
+			}
+		}
+		if winner < 0 || loser < 0 || loser > winner || standings[winner].Score != standings[loser].Score {
+			continue
+		}
+		standings[winner], standings[loser] = standings[loser], standings[winner]
+	}
+}
+
+// wonTiebreak reports whether a beat b in a decided tiebreak match.
+func wonTiebreak(winners [][2]string, a string, b string) bool {
+	for _, w := range winners {
+		if w[0] == a && w[1] == b {
+			return true
+		}
+	}
+	return false
+}
+
+// ScheduleTiebreakMatch adds an armageddon game between two players tied on points for a
+// podium place, once the regular rounds are over (the tournament is complete, or in a
+// knockout the current round is complete). The game is kept in TiebreakMatchesData, outside
+// the rounds: it never counts toward Score, Buchholz or any other tie-break and only decides
+// the order of the two players in GetStandings. playerA takes White; a draw is a win for Black.
+// Games are numbered from 1 in TableNumber; RoundNumber is the round they follow.
+func ScheduleTiebreakMatch(t *model.Tournament, playerA string, playerB string) error {
+	if playerA == playerB {
+		return fmt.Errorf("a player cannot play a tiebreak against themselves")
+	}
+	if t.Status != StatusComplete && t.PairingSystem != PairingSystemKnockout {
+		return fmt.Errorf("a tiebreak match can only be scheduled after the final round")
+	}
+
+	players, err := t.GetPlayers()
+	if err != nil {
+		return err
+	}
+	if err := ensureCurrentRoundComplete(t, players); err != nil {
+		return err
+	}
+
+	tiebreaks, err := t.GetTiebreakMatches()
+	if err != nil {
+		return err
+	}
+	for _, m := range tiebreaks {
+		if m.Result == "" {
+			return fmt.Errorf("tiebreak match %d has no result yet", m.TableNumber)
+		}
+	}
+
+	// Both players must share a score, with the better placed one on the podium
+	standings, err := GetStandings(t)
+	if err != nil {
+		return err
+	}
+	positionA, positionB := -1, -1
+	for i, p := range standings {
+		switch p.ID {
+		case playerA:
+			positionA = i
+		case playerB:
+			positionB = i
+		}
+	}
+	if positionA < 0 {
+		return fmt.Errorf("player not found: %s", playerA)
+	}
+	if positionB < 0 {
+		return fmt.Errorf("player not found: %s", playerB)
+	}
+	if standings[positionA].Score != standings[positionB].Score {
+		return fmt.Errorf("%s and %s are not tied on points", standings[positionA].Name, standings[positionB].Name)
+	}
+	if positionA > 2 && positionB > 2 {
+		return fmt.Errorf("%s and %s are not tied for a podium place", standings[positionA].Name, standings[positionB].Name)
+	}
+
+	match := model.Match{
+		RoundNumber: t.CurrentRound,
+		TableNumber: len(tiebreaks) + 1,
+		PlayerA_ID:  playerA,
+		PlayerB_ID:  playerB,
+		WhiteID:     playerA,
+		BlackID:     playerB,
+		Result:      "",
+	}
+	if err := t.SetTiebreakMatches(append(tiebreaks, match)); err != nil {
+		return err
+	}
+
+	events, _ := t.GetEvents()
+	detail := struct {
+		PlayerA string `json:"player_a"`
+		PlayerB string `json:"player_b"`
+	}{
+		PlayerA: playerA,
+		PlayerB: playerB,
+	}
+	detailJSON, _ := json.Marshal(detail)
+	events = append(events, model.Event{
+		EventID:     uuid.New(),
+		Type:        "TIEBREAK_SCHEDULED",
+		Timestamp:   time.Now(),
+		RoundNumber: match.RoundNumber,
+		TableNumber: match.TableNumber,
+		Details:     detailJSON,
+	})
+	return t.SetEvents(events)
+}
+
+// RecordTiebreakResult records the result of tiebreak match number game (1-based). result is
+// "A_WIN", "B_WIN", "DRAW" (a win for Black), "A_FORFEIT" or "B_FORFEIT". Tiebreak results
+// never touch the rounds, so they are accepted after the tournament is complete and are not
+// part of the undo history.
+func RecordTiebreakResult(t *model.Tournament, game int, result model.MatchResult) error {
+	switch result {
+	case model.ResultAWin, model.ResultBWin, model.ResultDraw, model.ResultAForfeit, model.ResultBForfeit:
+	default:
+		return fmt.Errorf("invalid tiebreak result: %s", result)
+	}
+
+	matches, err := t.GetTiebreakMatches()
+	if err != nil {
+		return err
+	}
+	if game < 1 || game > len(matches) {
+		return fmt.Errorf("tiebreak match %d not found", game)
+	}
+	match := &matches[game-1]
+	previous := *match
+
+	now := time.Now()
+	match.Result = result
+	match.Forfeit = isForfeitResult(result)
+	match.ResultRecordedAt = &now
+	if err := t.SetTiebreakMatches(matches); err != nil {
+		return err
+	}
+
+	events, _ := t.GetEvents()
+	detail := struct {
+		Match    model.Match `json:"match"`
+		Previous model.Match `json:"previous"`
+	}{
+		Match:    *match,
+		Previous: previous,
+	}
+	detailJSON, _ := json.Marshal(detail)
+	events = append(events, model.Event{
+		EventID:     uuid.New(),
+		Type:        "TIEBREAK_RESULT_RECORDED",
+		Timestamp:   now,
+		RoundNumber: match.RoundNumber,
+		TableNumber: match.TableNumber,
+		Details:     detailJSON,
+	})
+	return t.SetEvents(events)
+}
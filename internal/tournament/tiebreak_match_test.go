@@ -0,0 +1,55 @@
+package tournament
+
+import (
+	"testing"
+
+	"xchess-desktop/internal/model"
+)
+
+func TestTiebreakMatchDecidesPlacement(t *testing.T) {
+	tour := newTestTournament(t, 4)
+	tour.RoundsTotal = 2
+	playRound(t, tour)
+	playRound(t, tour)
+	if tour.Status != StatusComplete {
+		t.Fatalf("status = %s, want %s", tour.Status, StatusComplete)
+	}
+
+	standings, err := GetStandings(tour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, third := standings[1], standings[2]
+	if second.Score != third.Score {
+		t.Fatalf("places 2 and 3 are not level: %.1f vs %.1f", second.Score, third.Score)
+	}
+	roundsBefore := string(tour.RoundsData)
+
+	// Third place takes White and wins the armageddon
+	if err := ScheduleTiebreakMatch(tour, third.ID, second.ID); err != nil {
+		t.Fatal(err)
+	}
+	if err := RecordTiebreakResult(tour, 1, model.ResultAWin); err != nil {
+		t.Fatal(err)
+	}
+
+	if string(tour.RoundsData) != roundsBefore || tour.CurrentRound != 2 {
+		t.Error("the tiebreak match changed the rounds")
+	}
+	standings, err = GetStandings(tour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if standings[1].ID != third.ID || standings[2].ID != second.ID {
+		t.Errorf("after the tiebreak places 2 and 3 are %s and %s, want %s and %s",
+			standings[1].ID, standings[2].ID, third.ID, second.ID)
+	}
+	if standings[1].Score != third.Score {
+		t.Error("the tiebreak match changed the score")
+	}
+
+	// Another tournament round can no longer be paired, and the game is not one
+	if err := AdvanceToNextRound(tour, SwissToolAdapter{}); err == nil {
+		t.Error("AdvanceToNextRound paired a round after the tiebreak")
+	}
+}
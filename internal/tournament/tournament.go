@@ -9,7 +9,9 @@ package tournament
 import (
 	"encoding/json"
 	"fmt"
+	"math/rand"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -23,6 +25,7 @@ import (
 	"github.com/johnfercher/maroto/v2/pkg/components/row"
 	"github.com/johnfercher/maroto/v2/pkg/components/text"
 	"github.com/johnfercher/maroto/v2/pkg/config"
+	"github.com/johnfercher/maroto/v2/pkg/core"
 	"github.com/johnfercher/maroto/v2/pkg/consts/align"
 	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
 	"github.com/johnfercher/maroto/v2/pkg/props"
@@ -73,62 +76,354 @@ type PairingEngine interface {
 	GeneratePairings(t *model.Tournament, players []model.Player, roundNumber int) ([]model.Match, error)
 }
 
+// RelaxingPairingEngine is implemented by engines that can relax their constraints instead of
+// failing on an unpairable field. The warnings describe what had to be relaxed.
+type RelaxingPairingEngine interface {
+	GeneratePairingsWithRelaxation(t *model.Tournament, players []model.Player, roundNumber int) ([]model.Match, []string, error)
+}
+
+// generatePairings runs the engine on the players who have not withdrawn, relaxing constraints
+// when the engine supports it.
+func generatePairings(engine PairingEngine, t *model.Tournament, players []model.Player, roundNumber int) ([]model.Match, []string, error) {
+	players = activePlayers(players)
+	if relaxing, ok := engine.(RelaxingPairingEngine); ok {
+		return relaxing.GeneratePairingsWithRelaxation(t, players, roundNumber)
+	}
+	matches, err := engine.GeneratePairings(t, players, roundNumber)
+	return matches, nil, err
+}
+
 // SwissToolAdapter is an adapter entry-point for integrating the external "swiss-tool" library.
 // TODO: Replace the fallback implementation below with real calls to the swiss-tool package.
 type SwissToolAdapter struct{}
 
 // GeneratePairings integrates swisstool for Round 1 and uses model-driven Swiss for later rounds.
+// When the tournament is accelerated, rounds 1 and 2 are paired on accelerated score groups instead.
+// Players with a bye request for the round are set aside first and get a pre-scored bye.
 func (a SwissToolAdapter) GeneratePairings(t *model.Tournament, players []model.Player, roundNumber int) ([]model.Match, error) {
-	// Round 1: use swisstool random pairing directly
-	if roundNumber == 1 {
-		st := utils.NewTournamentWithConfig(utils.DefaultConfig())
-		// Add players using stable order; map utils IDs (1-based) to our players slice index
-		for i := range players {
-			// Use player ID to avoid duplicate-name constraints internally
-			_ = st.AddPlayer(players[i].ID)
-		}
-		if err := st.StartTournament(); err != nil {
+	pairable, requested, err := splitRequestedByes(t, players, roundNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := []model.Match{}
+	if len(pairable) > 0 {
+		win, _, _ := pointsSystem(t)
+		matches, err = a.pairPlayers(t, pairable, roundNumber, win, 0)
+		if err != nil {
 			return nil, err
 		}
-		pairings := st.GetRound()
+		setPairingByeValues(t, matches)
+	}
 
-		matches := make([]model.Match, 0, len(pairings))
-		for i, p := range pairings {
-			table := i + 1
-			if p.IsBye() {
-				aID := players[p.PlayerA()-1].ID
-				matches = append(matches, model.Match{
-					RoundNumber: roundNumber,
-					TableNumber: table,
-					PlayerA_ID:  aID,
-					PlayerB_ID:  ByePlayerID,
-					WhiteID:     aID,
-					BlackID:     "",
-					Result:      "",
-				})
-				continue
+	return append(matches, requestedByeMatches(requested, roundNumber, len(matches)+1)...), nil
+}
+
+// setPairingByeValues gives the pairing byes among matches the full bye value (ByeScore).
+func setPairingByeValues(t *model.Tournament, matches []model.Match) {
+	value := t.ByeScore
+	if value == 0 {
+		value = 1.0
+	}
+	for i := range matches {
+		if isBye(matches[i]) && !matches[i].RequestedBye {
+			matches[i].ByeValue = value
+		}
+	}
+}
+
+// GeneratePairingsWithRelaxation pairs like GeneratePairings, but when the field cannot be paired
+// under the hard constraints it relaxes them step by step instead of failing: first the allowed
+// score difference is widened one win at a time, then, as a last resort, a single rematch is allowed.
+// The returned warnings describe the relaxation that was applied (none when the strict pairing worked).
+func (a SwissToolAdapter) GeneratePairingsWithRelaxation(t *model.Tournament, players []model.Player, roundNumber int) ([]model.Match, []string, error) {
+	pairable, requested, err := splitRequestedByes(t, players, roundNumber)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	warnings := []string{}
+	matches := []model.Match{}
+	if len(pairable) > 0 {
+		win, _, _ := pointsSystem(t)
+
+		// Widest useful difference: the spread between the highest and lowest pairing score
+		maxScore, minScore := pairable[0].Score, pairable[0].Score
+		for _, p := range pairable {
+			if p.Score > maxScore {
+				maxScore = p.Score
+			}
+			if p.Score < minScore {
+				minScore = p.Score
+			}
+		}
+		spread := maxScore - minScore + win // accelerated bonus may add one win
+
+		matches, err = a.pairPlayers(t, pairable, roundNumber, win, 0)
+		for maxDiff := 2 * win; err != nil && maxDiff <= spread+win; maxDiff += win {
+			matches, err = a.pairPlayers(t, pairable, roundNumber, maxDiff, 0)
+			if err == nil {
+				warnings = append(warnings, fmt.Sprintf("Round %d required a score difference of up to %.1f", roundNumber, maxDiff))
+			}
+		}
+		if err != nil {
+			matches, err = a.pairPlayers(t, pairable, roundNumber, spread+win, 1)
+			if err == nil {
+				warnings = append(warnings, fmt.Sprintf("Round %d required 1 rematch", roundNumber))
+			}
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		warnings = append(warnings, forbiddenPairWarnings(t, matches, roundNumber)...)
+		setPairingByeValues(t, matches)
+	}
+
+	return append(matches, requestedByeMatches(requested, roundNumber, len(matches)+1)...), warnings, nil
+}
+
+// forbiddenPairAttempts is how many seeds the random first round tries to avoid forbidden pairs.
+const forbiddenPairAttempts = 100
+
+// forbiddenPairSet indexes the tournament's forbidden pairs in both orders.
+func forbiddenPairSet(t *model.Tournament) map[[2]string]bool {
+	set := make(map[[2]string]bool, 2*len(t.ForbiddenPairs))
+	for _, pair := range t.ForbiddenPairs {
+		set[pair] = true
+		set[[2]string{pair[1], pair[0]}] = true
+	}
+	return set
+}
+
+// containsForbiddenPair reports whether any match pairs a forbidden pair.
+func containsForbiddenPair(matches []model.Match, forbidden map[[2]string]bool) bool {
+	for _, m := range matches {
+		if forbidden[[2]string{m.PlayerA_ID, m.PlayerB_ID}] {
+			return true
+		}
+	}
+	return false
+}
+
+// forbiddenPairWarnings describes every forbidden pair the pairing could not avoid.
+func forbiddenPairWarnings(t *model.Tournament, matches []model.Match, roundNumber int) []string {
+	forbidden := forbiddenPairSet(t)
+	if len(forbidden) == 0 {
+		return nil
+	}
+	players, _ := t.GetPlayers()
+	var warnings []string
+	for _, m := range matches {
+		if forbidden[[2]string{m.PlayerA_ID, m.PlayerB_ID}] {
+			warnings = append(warnings, fmt.Sprintf("Round %d pairs forbidden pair %s vs %s at table %d",
+				roundNumber, getPlayerName(players, m.PlayerA_ID), getPlayerName(players, m.PlayerB_ID), m.TableNumber))
+		}
+	}
+	return warnings
+}
+
+// AddForbiddenPair prevents two players from being paired against each other.
+// The Swiss pairing treats them as having already played; only when no other pairing exists
+// are they paired anyway, with a warning on the round.
+func AddForbiddenPair(t *model.Tournament, playerA string, playerB string) error {
+	if playerA == playerB {
+		return fmt.Errorf("a player cannot be forbidden from playing themselves")
+	}
+	players, err := t.GetPlayers()
+	if err != nil {
+		return err
+	}
+	for _, id := range []string{playerA, playerB} {
+		found := false
+		for _, p := range players {
+			if p.ID == id {
+				found = true
+				break
 			}
+		}
+		if !found {
+			return fmt.Errorf("player not found: %s", id)
+		}
+	}
+	if forbiddenPairSet(t)[[2]string{playerA, playerB}] {
+		return fmt.Errorf("pair %s vs %s is already forbidden", playerA, playerB)
+	}
+	t.ForbiddenPairs = append(t.ForbiddenPairs, [2]string{playerA, playerB})
+	return nil
+}
+
+// RemoveForbiddenPair allows two players to be paired again.
+func RemoveForbiddenPair(t *model.Tournament, playerA string, playerB string) error {
+	for i, pair := range t.ForbiddenPairs {
+		if (pair[0] == playerA && pair[1] == playerB) || (pair[0] == playerB && pair[1] == playerA) {
+			t.ForbiddenPairs = append(t.ForbiddenPairs[:i], t.ForbiddenPairs[i+1:]...)
+			return nil
+		}
+	}
+	return fmt.Errorf("pair %s vs %s is not forbidden", playerA, playerB)
+}
+
+// randomFirstRound pairs round 1 randomly through swisstool, seeded with seed.
+func randomFirstRound(players []model.Player, roundNumber int, seed int64) ([]model.Match, error) {
+	st := utils.NewTournamentWithConfigAndSeed(utils.DefaultConfig(), seed)
+	// Add players using stable order; map utils IDs (1-based) to our players slice index
+	for i := range players {
+		// Use player ID to avoid duplicate-name constraints internally
+		_ = st.AddPlayer(players[i].ID)
+	}
+	if err := st.StartTournament(); err != nil {
+		return nil, err
+	}
+	pairings := st.GetRound()
+
+	matches := make([]model.Match, 0, len(pairings))
+	for i, p := range pairings {
+		table := i + 1
+		if p.IsBye() {
 			aID := players[p.PlayerA()-1].ID
-			bID := players[p.PlayerB()-1].ID
 			matches = append(matches, model.Match{
 				RoundNumber: roundNumber,
 				TableNumber: table,
 				PlayerA_ID:  aID,
-				PlayerB_ID:  bID,
+				PlayerB_ID:  ByePlayerID,
 				WhiteID:     aID,
-				BlackID:     bID,
+				BlackID:     "",
 				Result:      "",
 			})
+			continue
+		}
+		aID := players[p.PlayerA()-1].ID
+		bID := players[p.PlayerB()-1].ID
+		matches = append(matches, model.Match{
+			RoundNumber: roundNumber,
+			TableNumber: table,
+			PlayerA_ID:  aID,
+			PlayerB_ID:  bID,
+			WhiteID:     aID,
+			BlackID:     bID,
+			Result:      "",
+		})
+	}
+	return matches, nil
+}
+
+// recentPairings returns the pairs (both orders) that met in the t.RematchWindow rounds
+// before roundNumber. Byes and tiebreak games are ignored; nil when no window is set.
+func recentPairings(t *model.Tournament, roundNumber int) map[[2]string]bool {
+	if t.RematchWindow <= 0 {
+		return nil
+	}
+	recent := make(map[[2]string]bool)
+	rounds, err := t.GetRounds()
+	if err != nil {
+		return recent
+	}
+	for _, r := range rounds {
+		if r.RoundNumber >= roundNumber || r.RoundNumber < roundNumber-t.RematchWindow {
+			continue
+		}
+		for _, m := range r.Matches {
+			if isBye(m) {
+				continue
+			}
+			recent[[2]string{m.PlayerA_ID, m.PlayerB_ID}] = true
+			recent[[2]string{m.PlayerB_ID, m.PlayerA_ID}] = true
+		}
+	}
+	return recent
+}
+
+// SetRematchWindow lets players meet again once they have not played each other in the
+// last rounds rounds; 0 (the default) never allows a rematch.
+func SetRematchWindow(t *model.Tournament, rounds int) error {
+	if rounds < 0 {
+		return fmt.Errorf("invalid rematch window %d", rounds)
+	}
+	t.RematchWindow = rounds
+	return nil
+}
+
+// MinPlayers is the number of active players needed to pair round 1.
+const MinPlayers = 2
+
+// SetMaxPlayers sets the soft maximum field size; round 1 of a larger field is still paired
+// but carries a warning. 0 removes the limit.
+func SetMaxPlayers(t *model.Tournament, maxPlayers int) error {
+	if maxPlayers < 0 || (maxPlayers > 0 && maxPlayers < MinPlayers) {
+		return fmt.Errorf("invalid maximum of %d players", maxPlayers)
+	}
+	t.MaxPlayers = maxPlayers
+	return nil
+}
+
+// fieldSizeWarnings checks the field before round 1: fewer than MinPlayers active players
+// is an error, more than MaxPlayers only a warning.
+func fieldSizeWarnings(t *model.Tournament, players []model.Player) ([]string, error) {
+	active := len(activePlayers(players))
+	if active < MinPlayers {
+		return nil, fmt.Errorf("cannot start round 1: at least %d active players are required, got %d", MinPlayers, active)
+	}
+	if t.MaxPlayers > 0 && active > t.MaxPlayers {
+		return []string{fmt.Sprintf("Round 1 has %d players, above the maximum of %d", active, t.MaxPlayers)}, nil
+	}
+	return nil, nil
+}
+
+// pairPlayers pairs the given players for a round (the pairing bye for odd counts included),
+// allowing at most maxDiff points between opponents and at most maxRematches rematches.
+func (a SwissToolAdapter) pairPlayers(t *model.Tournament, players []model.Player, roundNumber int, maxDiff float64, maxRematches int) ([]model.Match, error) {
+	// Round 1 by rating: top half against bottom half
+	if roundNumber == 1 && !t.Accelerated && t.FirstRoundMethod == FirstRoundRating {
+		return pairByRating(players, roundNumber, forbiddenPairSet(t)), nil
+	}
+
+	// Round 1: use swisstool random pairing directly (accelerated events pair round 1 by score groups)
+	if roundNumber == 1 && !t.Accelerated {
+		// Seeded so the pairing can be reproduced; an unset seed is chosen now and kept on the tournament
+		ensurePairingSeed(t)
+		// Reshuffle with the next seeds while a forbidden pair comes up; the result stays reproducible
+		forbidden := forbiddenPairSet(t)
+		var matches []model.Match
+		for attempt := int64(0); attempt < forbiddenPairAttempts; attempt++ {
+			var err error
+			matches, err = randomFirstRound(players, roundNumber, t.PairingSeed+attempt)
+			if err != nil {
+				return nil, err
+			}
+			if !containsForbiddenPair(matches, forbidden) {
+				break
+			}
 		}
 		return matches, nil
 	}
 
+	// The accelerated bonus is one win so it follows the configured points system
+	win, _, _ := pointsSystem(t)
+
+	// Accelerated pairings: in rounds 1 and 2 the top group of the field (lowest StartRank
+	// first) gets a virtual win when forming score groups. The bonus is never added to Score.
+	bonus := make(map[string]float64, len(players))
+	if t.Accelerated && roundNumber <= 2 {
+		ranks := startRanks(players)
+		seeded := make([]model.Player, len(players))
+		copy(seeded, players)
+		sort.SliceStable(seeded, func(i, j int) bool {
+			return ranks[seeded[i].ID] < ranks[seeded[j].ID]
+		})
+		for i := 0; i < acceleratedGroupSize(len(seeded)); i++ {
+			bonus[seeded[i].ID] = win
+		}
+	}
+	pairingScore := func(p *model.Player) float64 {
+		return p.Score + bonus[p.ID]
+	}
+
 	// Subsequent rounds: Swiss-like pairing with hard constraints (no rematches, max score diff 1.0)
 	ps := make([]model.Player, len(players))
 	copy(ps, players)
 	sort.SliceStable(ps, func(i, j int) bool {
-		if ps[i].Score != ps[j].Score {
-			return ps[i].Score > ps[j].Score
+		if pairingScore(&ps[i]) != pairingScore(&ps[j]) {
+			return pairingScore(&ps[i]) > pairingScore(&ps[j])
 		}
 		if ps[i].Buchholz != ps[j].Buchholz {
 			return ps[i].Buchholz > ps[j].Buchholz
@@ -154,8 +449,17 @@ func (a SwissToolAdapter) GeneratePairings(t *model.Tournament, players []model.
 		}
 	}
 
-	// Helper: check if two players have played before
+	// Helper: check if two players have played before (forbidden pairs count as played).
+	// With a rematch window only games in the last RematchWindow rounds count.
+	forbidden := forbiddenPairSet(t)
+	recent := recentPairings(t, roundNumber)
 	havePlayed := func(a, b *model.Player) bool {
+		if forbidden[[2]string{a.ID, b.ID}] {
+			return true
+		}
+		if t.RematchWindow > 0 {
+			return recent[[2]string{a.ID, b.ID}]
+		}
 		for _, oid := range a.OpponentIDs {
 			if oid == b.ID {
 				return true
@@ -164,36 +468,11 @@ func (a SwissToolAdapter) GeneratePairings(t *model.Tournament, players []model.
 		return false
 	}
 
-	// Helper: choose bye candidate (lowest score, no prior bye if possible)
-	chooseBye := func(candidates []model.Player) *model.Player {
-		// Prefer lowest score and HasBye == false
-		sort.SliceStable(candidates, func(i, j int) bool {
-			if candidates[i].Score != candidates[j].Score {
-				return candidates[i].Score < candidates[j].Score
-			}
-			if candidates[i].Buchholz != candidates[j].Buchholz {
-				return candidates[i].Buchholz < candidates[j].Buchholz
-			}
-			return candidates[i].Name < candidates[j].Name
-		})
-		for i := range candidates {
-			if !candidates[i].HasBye {
-				return &candidates[i]
-			}
-		}
-		// If all have had bye, pick the absolute lowest
-		if len(candidates) > 0 {
-			return &candidates[0]
-		}
-		return nil
-	}
-
 	// Backtracking pairing under constraints
 	used := make(map[string]bool, len(ps))
+	rematches := 0
 	matches := make([]model.Match, 0, len(ps)/2+1)
 	table := 1
-	allowBye := len(ps)%2 == 1
-	byeAssigned := false
 
 	abs := func(x float64) float64 {
 		if x < 0 {
@@ -223,22 +502,25 @@ func (a SwissToolAdapter) GeneratePairings(t *model.Tournament, players []model.
 			return true
 		}
 
-		// Build candidate list: not used, no rematch, within score diff <= 1.0
+		// Build candidate list: not used, no rematch (beyond the allowance), within maxDiff
 		type cand struct {
-			j         int
-			scoreDiff float64
-			tableProx int
+			j             int
+			rematch       bool
+			scoreDiff     float64
+			colorConflict bool
+			tableProx     int
 		}
 		var cands []cand
 		for j := range ps {
 			if ps[j].ID == a.ID || used[ps[j].ID] {
 				continue
 			}
-			if havePlayed(a, &ps[j]) {
+			rematch := havePlayed(a, &ps[j])
+			if rematch && rematches >= maxRematches {
 				continue
 			}
-			diff := abs(a.Score - ps[j].Score)
-			if diff > 1.0 {
+			diff := abs(pairingScore(a) - pairingScore(&ps[j]))
+			if diff > maxDiff {
 				continue
 			}
 			// Prefer pairing with closest previous tables (secondary priority)
@@ -248,26 +530,41 @@ func (a SwissToolAdapter) GeneratePairings(t *model.Tournament, players []model.
 			if aTable > 0 && bTable > 0 {
 				prox = intAbs(aTable - bTable)
 			}
-			cands = append(cands, cand{j: j, scoreDiff: diff, tableProx: prox})
+			cands = append(cands, cand{j: j, rematch: rematch, scoreDiff: diff, colorConflict: colorConflict(a, &ps[j]), tableProx: prox})
 		}
-		// Prefer same-score (diff=0), then closest previous tables
+		// Prefer new opponents, then same-score (diff=0), then pairings that avoid a third same color, then closest previous tables
 		sort.SliceStable(cands, func(i, j int) bool {
+			if cands[i].rematch != cands[j].rematch {
+				return !cands[i].rematch
+			}
 			if cands[i].scoreDiff != cands[j].scoreDiff {
 				return cands[i].scoreDiff < cands[j].scoreDiff
 			}
+			if cands[i].colorConflict != cands[j].colorConflict {
+				return !cands[i].colorConflict
+			}
 			return cands[i].tableProx < cands[j].tableProx
 		})
 
 		for _, c := range cands {
 			b := &ps[c.j]
-			white := a
-			black := b
-			if len(white.ColorHistory) > 0 && white.ColorHistory[len(white.ColorHistory)-1] == 'W' {
-				white, black = black, white
+			white, black := assignColors(a, b)
+
+			// Annotate floats from Player A's perspective (B floats the opposite way)
+			floatType := ""
+			if roundNumber >= 2 {
+				if pairingScore(a) > pairingScore(b) {
+					floatType = "DOWN"
+				} else if pairingScore(a) < pairingScore(b) {
+					floatType = "UP"
+				}
 			}
 
 			used[a.ID] = true
 			used[b.ID] = true
+			if c.rematch {
+				rematches++
+			}
 			matches = append(matches, model.Match{
 				RoundNumber: roundNumber,
 				TableNumber: table,
@@ -276,6 +573,7 @@ func (a SwissToolAdapter) GeneratePairings(t *model.Tournament, players []model.
 				WhiteID:     white.ID,
 				BlackID:     black.ID,
 				Result:      "",
+				FloatType:   floatType,
 			})
 			table++
 
@@ -286,22 +584,24 @@ func (a SwissToolAdapter) GeneratePairings(t *model.Tournament, players []model.
 			// Undo
 			table--
 			matches = matches[:len(matches)-1]
+			if c.rematch {
+				rematches--
+			}
 			used[b.ID] = false
 			used[a.ID] = false
 		}
 
-		// If no candidate found, try assigning BYE only if allowed and not yet assigned.
-		// Assign BYE to the actual low-score candidate among remaining unpaired players.
-		if allowBye && !byeAssigned {
-			var remaining []model.Player
-			for i := range ps {
-				if !used[ps[i].ID] {
-					remaining = append(remaining, ps[i])
-				}
-			}
-			if bye := chooseBye(remaining); bye != nil {
-				// Assign BYE to the selected 'bye' player
-				used[bye.ID] = true
+		return false
+	}
+
+	// With an odd count the bye is set aside first, trying candidates in ByePolicy order until
+	// the rest of the field can be paired. Only the players with the fewest previous byes are
+	// tried, so nobody gets a second bye while an active player has not had one.
+	paired := false
+	if len(ps)%2 == 1 {
+		for _, bye := range byeCandidates(t, ps, pairingScore, roundNumber) {
+			used[bye.ID] = true
+			if backtrack() {
 				matches = append(matches, model.Match{
 					RoundNumber: roundNumber,
 					TableNumber: table,
@@ -311,26 +611,20 @@ func (a SwissToolAdapter) GeneratePairings(t *model.Tournament, players []model.
 					BlackID:     "",
 					Result:      "",
 				})
-				table++
-				byeAssigned = true
-
-				if backtrack() {
-					return true
-				}
-
-				// Undo
-				byeAssigned = false
-				table--
-				matches = matches[:len(matches)-1]
-				used[bye.ID] = false
+				paired = true
+				break
 			}
+			used[bye.ID] = false
 		}
-
-		return false
+	} else {
+		paired = backtrack()
 	}
 
-	if !backtrack() {
-		return nil, fmt.Errorf("unable to generate pairings: no rematches and max score difference 1.0 constraints cannot be satisfied")
+	if !paired {
+		if maxRematches > 0 {
+			return nil, fmt.Errorf("unable to generate pairings: even with %d rematch(es) and max score difference %.1f", maxRematches, maxDiff)
+		}
+		return nil, fmt.Errorf("unable to generate pairings: no rematches and max score difference %.1f constraints cannot be satisfied", maxDiff)
 	}
 
 	return matches, nil
@@ -338,899 +632,3931 @@ func (a SwissToolAdapter) GeneratePairings(t *model.Tournament, players []model.
 
 const ByePlayerID = "BYE"
 
-// InitializeTournament sets minimal fields and attaches players.
-// Title is required; players will be serialized into PlayersData.
-// PairingSystem defaults to "SWISS"; ByeScore defaults to 1.0 if unset.
-func InitializeTournament(t *model.Tournament, title string, description string, players []model.Player) error {
-	// Validate required fields
-	if strings.TrimSpace(title) == "" {
-		return fmt.Errorf("field must be filled: Title is required")
+// Bye policies for Tournament.ByePolicy: who gets the pairing bye from round 2 on.
+const (
+	ByePolicyLowest  = "LOWEST"
+	ByePolicyHighest = "HIGHEST"
+	ByePolicyRandom  = "RANDOM"
+)
+
+// pairingByeCounts counts the pairing byes (requested byes excluded) each player received
+// in the rounds before roundNumber.
+func pairingByeCounts(t *model.Tournament, roundNumber int) map[string]int {
+	counts := make(map[string]int)
+	rounds, err := t.GetRounds()
+	if err != nil {
+		return counts
 	}
-	if strings.TrimSpace(description) == "" {
-		return fmt.Errorf("field must be filled: Description is required")
+	for _, r := range rounds {
+		if r.RoundNumber >= roundNumber {
+			continue
+		}
+		for _, m := range r.Matches {
+			if isBye(m) && !m.RequestedBye {
+				counts[byeRecipient(m)]++
+			}
+		}
 	}
+	return counts
+}
 
-	t.Title = title
-	t.Description = description
-	t.Status = "ACTIVE"
-	t.StartTime = time.Now()
-	t.CurrentRound = 0
-	t.TotalPlayers = len(players)
-	if t.PairingSystem == "" {
-		t.PairingSystem = "SWISS"
+// byeCandidates orders the players for the pairing bye according to t.ByePolicy, or by rating
+// when t.ByeByRating is set.
+// Players are grouped by the number of pairing byes they already had (HasBye counts as at
+// least one), fewest first, so byes are spread evenly: nobody gets a second bye before every
+// player has had one. RANDOM is seeded from PairingSeed and the round so the draw can be reproduced.
+// Only the players with the fewest byes are returned.
+func byeCandidates(t *model.Tournament, players []model.Player, pairingScore func(p *model.Player) float64, roundNumber int) []model.Player {
+	candidates := make([]model.Player, len(players))
+	copy(candidates, players)
+
+	switch {
+	case t.ByeByRating:
+		// Lowest rated first; unrated players (Rating 0) count as the lowest
+		sort.SliceStable(candidates, func(i, j int) bool {
+			if candidates[i].Rating != candidates[j].Rating {
+				return candidates[i].Rating < candidates[j].Rating
+			}
+			if pairingScore(&candidates[i]) != pairingScore(&candidates[j]) {
+				return pairingScore(&candidates[i]) < pairingScore(&candidates[j])
+			}
+			return candidates[i].Name < candidates[j].Name
+		})
+	case t.ByePolicy == ByePolicyRandom:
+		sort.SliceStable(candidates, func(i, j int) bool {
+			return candidates[i].ID < candidates[j].ID
+		})
+		rng := rand.New(rand.NewSource(t.PairingSeed + int64(roundNumber)))
+		rng.Shuffle(len(candidates), func(i, j int) {
+			candidates[i], candidates[j] = candidates[j], candidates[i]
+		})
+	case t.ByePolicy == ByePolicyHighest:
+		sort.SliceStable(candidates, func(i, j int) bool {
+			if pairingScore(&candidates[i]) != pairingScore(&candidates[j]) {
+				return pairingScore(&candidates[i]) > pairingScore(&candidates[j])
+			}
+			if candidates[i].Buchholz != candidates[j].Buchholz {
+				return candidates[i].Buchholz > candidates[j].Buchholz
+			}
+			return candidates[i].Name < candidates[j].Name
+		})
+	default:
+		sort.SliceStable(candidates, func(i, j int) bool {
+			if pairingScore(&candidates[i]) != pairingScore(&candidates[j]) {
+				return pairingScore(&candidates[i]) < pairingScore(&candidates[j])
+			}
+			if candidates[i].Buchholz != candidates[j].Buchholz {
+				return candidates[i].Buchholz < candidates[j].Buchholz
+			}
+			return candidates[i].Name < candidates[j].Name
+		})
 	}
-	if t.ByeScore == 0 {
-		t.ByeScore = 1.0
+
+	// Byes received so far, precomputed once for the whole field
+	counts := pairingByeCounts(t, roundNumber)
+	byes := func(p *model.Player) int {
+		if p.HasBye && counts[p.ID] == 0 {
+			return 1
+		}
+		return counts[p.ID]
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return byes(&candidates[i]) < byes(&candidates[j])
+	})
+	for i := range candidates {
+		if byes(&candidates[i]) > byes(&candidates[0]) {
+			return candidates[:i]
+		}
 	}
+	return candidates
+}
 
-	// Persist players
-	if err := t.SetPlayers(players); err != nil {
-		return err
+// SetByePolicy sets who receives the pairing bye: ByePolicyLowest, ByePolicyHighest or ByePolicyRandom.
+func SetByePolicy(t *model.Tournament, policy string) error {
+	switch policy {
+	case ByePolicyLowest, ByePolicyHighest, ByePolicyRandom:
+		t.ByePolicy = policy
+		return nil
 	}
+	return fmt.Errorf("unknown bye policy %q", policy)
+}
 
-	// Initialize empty rounds
-	if err := t.SetRounds([]model.Round{}); err != nil {
-		return err
+// PairingSystemSwiss is the default Tournament.PairingSystem value.
+const PairingSystemSwiss = "SWISS"
+
+// engineRegistry maps pairing system names to engine factories. The built-in engines register
+// themselves in init; other engines are added with RegisterEngine.
+var engineRegistry = map[string]func() PairingEngine{}
+
+func init() {
+	RegisterEngine(PairingSystemSwiss, func() PairingEngine { return SwissToolAdapter{} })
+}
+
+// RegisterEngine adds or replaces the pairing engine of a pairing system.
+func RegisterEngine(name string, factory func() PairingEngine) {
+	engineRegistry[name] = factory
+}
+
+// NewEngine returns a new instance of the engine registered under name.
+func NewEngine(name string) (PairingEngine, error) {
+	factory, ok := engineRegistry[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown pairing system %q", name)
 	}
+	return factory(), nil
+}
 
-	return nil
+// PairingEngineFor returns the pairing engine of a pairing system ("" is Swiss).
+func PairingEngineFor(system string) (PairingEngine, error) {
+	if system == "" {
+		system = PairingSystemSwiss
+	}
+	return NewEngine(system)
 }
 
-// RecordMatchResult updates the specified match result and player standings.
-// result must be one of: "A_WIN", "B_WIN", "DRAW", "BYE_A".
-func RecordMatchResult(t *model.Tournament, roundNumber int, tableNumber int, result string) error {
-	rounds, err := t.GetRounds()
+// SetPairingSystem switches the event format before round 1 and adjusts RoundsTotal to it:
+// the full schedule for a round robin, none for a knockout (it ends with the champion) and
+// RecommendRounds for Swiss and team events coming from either of those.
+func SetPairingSystem(t *model.Tournament, system string) error {
+	if _, err := PairingEngineFor(system); err != nil {
+		return err
+	}
+	if t.CurrentRound > 0 {
+		return fmt.Errorf("cannot change the pairing system after round %d has been paired", t.CurrentRound)
+	}
+	players, err := t.GetPlayers()
 	if err != nil {
 		return err
 	}
 
-	// Locate the target match and round
-	var match *model.Match
-	var targetRound *model.Round
-	for r := range rounds {
-		if rounds[r].RoundNumber != roundNumber {
-			continue
-		}
-		targetRound = &rounds[r]
-		for m := range rounds[r].Matches {
-			if rounds[r].Matches[m].TableNumber == tableNumber {
-				match = &rounds[r].Matches[m]
-				break
-			}
-		}
-		if match != nil {
-			break
+	previous := t.PairingSystem
+	t.PairingSystem = system
+	switch system {
+	case PairingSystemRoundRobin:
+		t.RoundsTotal = RoundRobinRounds(t, len(players))
+	case PairingSystemKnockout:
+		t.RoundsTotal = 0
+	default:
+		if t.RoundsTotal == 0 || previous == PairingSystemRoundRobin || previous == PairingSystemKnockout {
+			t.RoundsTotal = RecommendRounds(len(players))
 		}
 	}
-	if match == nil {
-		return fmt.Errorf("match not found for round %d, table %d", roundNumber, tableNumber)
-	}
+	return nil
+}
 
-	// Validate BYE consistency
-	if result == "BYE_A" && match.PlayerB_ID != ByePlayerID {
-		return fmt.Errorf("invalid result BYE_A for non-bye match at round %d, table %d", roundNumber, tableNumber)
+// Tournament statuses.
+const (
+	StatusSetup    = "SETUP"
+	StatusActive   = "ACTIVE"
+	StatusComplete = "COMPLETE"
+)
+
+// statusTransitions lists the legal status changes: SETUP -> ACTIVE -> COMPLETE, and
+// COMPLETE -> ACTIVE when a tournament is reopened.
+var statusTransitions = map[string][]string{
+	StatusSetup:    {StatusActive},
+	StatusActive:   {StatusComplete},
+	StatusComplete: {StatusActive},
+}
+
+// SetStatus changes the tournament status, rejecting illegal transitions such as COMPLETE -> SETUP.
+func SetStatus(t *model.Tournament, status string) error {
+	for _, next := range statusTransitions[t.Status] {
+		if next == status {
+			t.Status = status
+			return nil
+		}
 	}
+	return fmt.Errorf("cannot change tournament status from %s to %s", t.Status, status)
+}
 
-	// Overwrite match result and scores (supports resubmission safely)
-	switch result {
-	case "A_WIN":
-		match.Result = "A_WIN"
-		match.ScoreA = 1.0
-		match.ScoreB = 0.0
-	case "B_WIN":
-		match.Result = "B_WIN"
-		match.ScoreA = 0.0
-		match.ScoreB = 1.0
-	case "DRAW":
-		match.Result = "DRAW"
-		match.ScoreA = 0.5
-		match.ScoreB = 0.5
-	case "BYE_A":
-		match.Result = "BYE_A"
-		if t.ByeScore == 0 {
-			t.ByeScore = 1.0
-		}
-		match.ScoreA = t.ByeScore
-		match.ScoreB = 0.0
-	default:
-		return fmt.Errorf("unknown result %q", result)
+// First round methods for Tournament.FirstRoundMethod (empty means random).
+const (
+	FirstRoundRandom = "RANDOM"
+	FirstRoundRating = "RATING"
+)
+
+// First round colors for Tournament.FirstRoundTopColor: the color of the higher seed
+// (better StartRank) in each round 1 game. Empty keeps the pairing method's own colors.
+const (
+	TopColorWhite     = "WHITE"
+	TopColorBlack     = "BLACK"
+	TopColorAlternate = "ALTERNATE" // White on table 1, Black on table 2, ...
+)
+
+// SetFirstRoundTopColor chooses the higher seed's color in round 1; only before round 1.
+func SetFirstRoundTopColor(t *model.Tournament, color string) error {
+	if t.CurrentRound > 0 {
+		return fmt.Errorf("first round colors can only be changed before round 1")
+	}
+	switch color {
+	case "", TopColorWhite, TopColorBlack, TopColorAlternate:
+		t.FirstRoundTopColor = color
+		return nil
 	}
+	return fmt.Errorf("unknown first round color %q", color)
+}
 
-	// Check if all matches in this round are now complete
-	allComplete := true
-	for _, m := range targetRound.Matches {
-		if m.Result == "" {
-			allComplete = false
-			break
+// applyFirstRoundColors sets White and Black of a Swiss round 1, already in table order,
+// following t.FirstRoundTopColor. Byes are skipped and do not count for alternation.
+func applyFirstRoundColors(t *model.Tournament, players []model.Player, matches []model.Match) {
+	if t.CurrentRound != 0 || t.FirstRoundTopColor == "" {
+		return
+	}
+	if t.PairingSystem != "" && t.PairingSystem != PairingSystemSwiss {
+		return
+	}
+
+	ranks := startRanks(players)
+	board := 0
+	for i := range matches {
+		m := &matches[i]
+		if isBye(*m) {
+			continue
+		}
+		top, bottom := m.PlayerA_ID, m.PlayerB_ID
+		if ranks[bottom] < ranks[top] {
+			top, bottom = bottom, top
+		}
+		topWhite := t.FirstRoundTopColor == TopColorWhite ||
+			(t.FirstRoundTopColor == TopColorAlternate && board%2 == 0)
+		if topWhite {
+			m.WhiteID, m.BlackID = top, bottom
+		} else {
+			m.WhiteID, m.BlackID = bottom, top
 		}
+		board++
 	}
-	targetRound.IsComplete = allComplete
+}
 
-	// Persist updated rounds before recompute
-	if err := t.SetRounds(rounds); err != nil {
-		return err
+// pairByRating pairs round 1 top half against bottom half by rating: 1 vs n/2+1, 2 vs n/2+2, ...
+// With an odd field the lowest-rated player gets the bye. The higher-rated player has White on
+// table 1 and colors alternate down the tables. A forbidden pair is avoided by swapping the
+// bottom-half player with the nearest bottom-half neighbour that leaves both boards allowed.
+func pairByRating(players []model.Player, roundNumber int, forbidden map[[2]string]bool) []model.Match {
+	ps := make([]model.Player, len(players))
+	copy(ps, players)
+	sort.SliceStable(ps, func(i, j int) bool {
+		if ps[i].Rating != ps[j].Rating {
+			return ps[i].Rating > ps[j].Rating
+		}
+		return ps[i].Name < ps[j].Name
+	})
+
+	var bye *model.Player
+	if len(ps)%2 == 1 {
+		bye = &ps[len(ps)-1]
+		ps = ps[:len(ps)-1]
 	}
 
-	// Recompute all players (Score, ColorHistory, HasBye, OpponentIDs) from all recorded matches
-	if err := RecomputePlayersFromRounds(t); err != nil {
-		return err
+	half := len(ps) / 2
+	allowed := func(i, j int) bool {
+		return !forbidden[[2]string{ps[i].ID, ps[half+j].ID}]
+	}
+	for i := 0; i < half; i++ {
+		if allowed(i, i) {
+			continue
+		}
+		for distance := 1; distance < half; distance++ {
+			swapped := false
+			for _, j := range []int{i + distance, i - distance} {
+				if j < 0 || j >= half || !allowed(i, j) || !allowed(j, i) {
+					continue
+				}
+				ps[half+i], ps[half+j] = ps[half+j], ps[half+i]
+				swapped = true
+				break
+			}
+			if swapped {
+				break
+			}
+		}
+		// Unavoidable pairs stay as they are; forbiddenPairWarnings reports them
 	}
 
-	// Remove previous MATCH_RESULT_RECORDED event for this round/table to avoid double spending
-	events, _ := t.GetEvents()
-	filtered := make([]model.Event, 0, len(events))
-	for _, e := range events {
-		if !(e.Type == "MATCH_RESULT_RECORDED" && e.RoundNumber == roundNumber && e.TableNumber == tableNumber) {
-			filtered = append(filtered, e)
+	matches := make([]model.Match, 0, half+1)
+	for i := 0; i < half; i++ {
+		top, bottom := ps[i].ID, ps[half+i].ID
+		white, black := top, bottom
+		if i%2 == 1 {
+			white, black = bottom, top
 		}
+		matches = append(matches, model.Match{
+			RoundNumber: roundNumber,
+			TableNumber: i + 1,
+			PlayerA_ID:  top,
+			PlayerB_ID:  bottom,
+			WhiteID:     white,
+			BlackID:     black,
+			Result:      "",
+		})
+	}
+	if bye != nil {
+		matches = append(matches, model.Match{
+			RoundNumber: roundNumber,
+			TableNumber: half + 1,
+			PlayerA_ID:  bye.ID,
+			PlayerB_ID:  ByePlayerID,
+			WhiteID:     bye.ID,
+			BlackID:     "",
+			Result:      "",
+		})
 	}
-	events = filtered
+	return matches
+}
 
-	// Append event: MATCH_RESULT_RECORDED with match snapshot
-	detail := struct {
-		Match model.Match `json:"match"`
-	}{
-		Match: *match,
+// pointsSystem returns the points for a win, draw and loss (1 / 0.5 / 0 when unset).
+func pointsSystem(t *model.Tournament) (win, draw, loss float64) {
+	if t.PointsWin == 0 {
+		return 1.0, 0.5, 0.0
 	}
-	detailJSON, _ := json.Marshal(detail)
-	events = append(events, model.Event{
-		EventID:     uuid.New(),
-		Type:        "MATCH_RESULT_RECORDED",
-		Timestamp:   time.Now(),
-		RoundNumber: roundNumber,
-		TableNumber: tableNumber,
-		Details:     detailJSON,
-	})
-	if err := t.SetEvents(events); err != nil {
+	return t.PointsWin, t.PointsDraw, t.PointsLoss
+}
+
+// gameScores returns Player A's and Player B's points for a game or forfeit result.
+// ok is false for byes and empty results, whose scores are not derived from the points system.
+func gameScores(t *model.Tournament, result model.MatchResult) (scoreA, scoreB float64, ok bool) {
+	win, draw, loss := pointsSystem(t)
+	switch result {
+	case model.ResultAWin, model.ResultBForfeit:
+		return win, loss, true
+	case model.ResultBWin, model.ResultAForfeit:
+		return loss, win, true
+	case model.ResultDraw:
+		return draw, draw, true
+	case model.ResultDoubleForfeit:
+		return 0, 0, true
+	}
+	return 0, 0, false
+}
+
+// isForfeitResult reports whether the result code is a forfeit (the game was not played).
+func isForfeitResult(result model.MatchResult) bool {
+	return result == model.ResultAForfeit || result == model.ResultBForfeit || result == model.ResultDoubleForfeit
+}
+
+// ValidResultsFor returns the result codes RecordMatchResult accepts for a match: only the
+// bye result on the real player's side for a bye, the game and forfeit results otherwise.
+func ValidResultsFor(m model.Match) []model.MatchResult {
+	switch {
+	case m.PlayerB_ID == ByePlayerID:
+		return []model.MatchResult{model.ResultByeA}
+	case m.PlayerA_ID == ByePlayerID:
+		return []model.MatchResult{model.ResultByeB}
+	}
+	return []model.MatchResult{
+		model.ResultAWin,
+		model.ResultBWin,
+		model.ResultDraw,
+		model.ResultAForfeit,
+		model.ResultBForfeit,
+		model.ResultDoubleForfeit,
+	}
+}
+
+// SetPointsSystem changes the points for a win, draw and loss and rescores all recorded games.
+func SetPointsSystem(t *model.Tournament, win, draw, loss float64) error {
+	if win <= 0 || draw < 0 || loss < 0 {
+		return fmt.Errorf("invalid points system %.1f/%.1f/%.1f", win, draw, loss)
+	}
+	if !(win >= draw && draw >= loss) {
+		return fmt.Errorf("invalid points system %.1f/%.1f/%.1f: win >= draw >= loss is required", win, draw, loss)
+	}
+	t.PointsWin, t.PointsDraw, t.PointsLoss = win, draw, loss
+
+	if err := RecomputePlayersFromRounds(t); err != nil {
 		return err
 	}
+	return UpdateStandings(t)
+}
 
-	// Recompute standings (including Buchholz)
-	UpdateStandings(t)
+// isBye reports whether the match is a bye, whichever slot holds ByePlayerID.
+func isBye(m model.Match) bool {
+	return m.PlayerA_ID == ByePlayerID || m.PlayerB_ID == ByePlayerID
+}
 
-	return nil
+// byeRecipient returns the real player of a bye match.
+func byeRecipient(m model.Match) string {
+	if m.PlayerA_ID == ByePlayerID {
+		return m.PlayerB_ID
+	}
+	return m.PlayerA_ID
 }
 
-func ensureOpponent(p *model.Player, oid string) {
-	for _, id := range p.OpponentIDs {
-		if id == oid {
-			return
+// pairingRowSides resolves the white/black names and current points printed for a match.
+// For byes the real player is printed on their color's side (White when unset) and "BYE" opposite.
+func pairingRowSides(players []model.Player, playerMap map[string]model.Player, match model.Match) (whitePlayer, whitePoints, blackPlayer, blackPoints string) {
+	whiteID, blackID := match.WhiteID, match.BlackID
+	if isBye(match) {
+		recipient := byeRecipient(match)
+		if match.BlackID == recipient {
+			whiteID = ByePlayerID
+		} else {
+			whiteID, blackID = recipient, ByePlayerID
 		}
 	}
-	p.OpponentIDs = append(p.OpponentIDs, oid)
+
+	whitePlayer = getPlayerName(players, whiteID)
+	blackPlayer = getPlayerName(players, blackID)
+
+	points := func(id string) string {
+		if id == ByePlayerID {
+			return "-"
+		}
+		if p, exists := playerMap[id]; exists {
+			return fmt.Sprintf("%.1f", p.Score)
+		}
+		return "0.0"
+	}
+	return whitePlayer, points(whiteID), blackPlayer, points(blackID)
 }
 
-// UpdateStandings recomputes Buchholz, Progressive Score, and Head-to-Head for all players.
-func UpdateStandings(t *model.Tournament) error {
-	players, err := t.GetPlayers()
+// splitRequestedByes separates the players who requested a bye for the round from those to be paired.
+func splitRequestedByes(t *model.Tournament, players []model.Player, roundNumber int) ([]model.Player, []model.ByeRequest, error) {
+	requests, err := t.GetByeRequests()
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
-	
-	rounds, err := t.GetRounds()
-	if err != nil {
-		return err
+
+	byPlayer := make(map[string]model.ByeRequest)
+	for _, req := range requests {
+		if req.RoundNumber == roundNumber {
+			byPlayer[req.PlayerID] = req
+		}
+	}
+	if len(byPlayer) == 0 {
+		return players, nil, nil
 	}
 
-	// Build score index
-	scoreIndex := make(map[string]float64, len(players))
+	pairable := make([]model.Player, 0, len(players))
+	requested := make([]model.ByeRequest, 0, len(byPlayer))
 	for _, p := range players {
-		scoreIndex[p.ID] = p.Score
+		if req, ok := byPlayer[p.ID]; ok {
+			requested = append(requested, req)
+			continue
+		}
+		pairable = append(pairable, p)
 	}
+	return pairable, requested, nil
+}
 
-	// Initialize Head-to-Head results for all players
-	for i := range players {
-		p := &players[i]
-		if p.HeadToHeadResults == nil {
-			p.HeadToHeadResults = make(model.HeadToHeadMap)
+// requestedByeMatches builds the pre-scored bye matches for requested byes, starting at the given table.
+func requestedByeMatches(requested []model.ByeRequest, roundNumber int, firstTable int) []model.Match {
+	matches := make([]model.Match, 0, len(requested))
+	for i, req := range requested {
+		matches = append(matches, model.Match{
+			RoundNumber:  roundNumber,
+			TableNumber:  firstTable + i,
+			PlayerA_ID:   req.PlayerID,
+			PlayerB_ID:   ByePlayerID,
+			WhiteID:      req.PlayerID,
+			BlackID:      "",
+			Result:       model.ResultByeA,
+			ScoreA:       req.Value,
+			ScoreB:       0.0,
+			RequestedBye: true,
+			ByeValue:     req.Value,
+		})
+	}
+	return matches
+}
+
+// requestedByeValue returns the value of a player's bye request for a round, if any.
+func requestedByeValue(t *model.Tournament, playerID string, roundNumber int) (float64, bool) {
+	requests, err := t.GetByeRequests()
+	if err != nil {
+		return 0, false
+	}
+	for _, req := range requests {
+		if req.PlayerID == playerID && req.RoundNumber == roundNumber {
+			return req.Value, true
 		}
 	}
+	return 0, false
+}
 
-	// Index players by ID for fast updates
-	playerIndex := make(map[string]*model.Player)
-	for i := range players {
-		p := &players[i]
-		// Reset Progressive Score and Head-to-Head
-		p.ProgressiveScore = 0
-		p.HeadToHeadResults = make(model.HeadToHeadMap)
-		playerIndex[p.ID] = p
+// byeValue returns the points for the bye of playerID in match: the match's own ByeValue,
+// else the value of the player's bye request, else the tournament's ByeScore.
+func byeValue(t *model.Tournament, match *model.Match, playerID string) float64 {
+	if match.ByeValue != 0 {
+		return match.ByeValue
+	}
+	if match.RequestedBye {
+		if value, ok := requestedByeValue(t, playerID, match.RoundNumber); ok {
+			return value
+		}
+	}
+	if t.ByeScore == 0 {
+		t.ByeScore = 1.0
 	}
+	return t.ByeScore
+}
 
-	// Calculate Progressive Score and Head-to-Head from all completed rounds
-	for roundNum := 1; roundNum <= t.CurrentRound; roundNum++ {
-		// Find the round
-		var currentRound *model.Round
-		for _, r := range rounds {
-			if r.RoundNumber == roundNum {
-				currentRound = &r
-				break
-			}
+// RequestBye records a bye requested in advance by a player for a future round.
+// The player is left out of the pairings of that round and receives a bye worth value
+// (typically 0.5) instead of ByeScore. A new request for the same round replaces the old one.
+func RequestBye(t *model.Tournament, playerID string, roundNumber int, value float64) error {
+	if roundNumber <= t.CurrentRound {
+		return fmt.Errorf("round %d has already been paired", roundNumber)
+	}
+	if t.RoundsTotal > 0 && roundNumber > t.RoundsTotal {
+		return fmt.Errorf("round %d exceeds the total of %d rounds", roundNumber, t.RoundsTotal)
+	}
+	if win, _, _ := pointsSystem(t); value < 0 || value > win {
+		return fmt.Errorf("invalid bye value %.1f", value)
+	}
+
+	players, err := t.GetPlayers()
+	if err != nil {
+		return err
+	}
+	found := false
+	for _, p := range players {
+		if p.ID == playerID {
+			found = true
+			break
 		}
-		if currentRound == nil {
-			continue
+	}
+	if !found {
+		return fmt.Errorf("player not found: %s", playerID)
+	}
+
+	requests, err := t.GetByeRequests()
+	if err != nil {
+		return err
+	}
+	filtered := make([]model.ByeRequest, 0, len(requests)+1)
+	for _, req := range requests {
+		if !(req.PlayerID == playerID && req.RoundNumber == roundNumber) {
+			filtered = append(filtered, req)
 		}
+	}
+	filtered = append(filtered, model.ByeRequest{PlayerID: playerID, RoundNumber: roundNumber, Value: value})
+	if err := t.SetByeRequests(filtered); err != nil {
+		return err
+	}
 
-		// Process matches in this round
-		for _, m := range currentRound.Matches {
-			if m.Result == "" || m.PlayerB_ID == ByePlayerID {
-				continue
-			}
+	// Append event: BYE_REQUESTED
+	events, _ := t.GetEvents()
+	detail := struct {
+		PlayerID string  `json:"player_id"`
+		Value    float64 `json:"value"`
+	}{
+		PlayerID: playerID,
+		Value:    value,
+	}
+	detailJSON, _ := json.Marshal(detail)
+	events = append(events, model.Event{
+		EventID:     uuid.New(),
+		Type:        "BYE_REQUESTED",
+		Timestamp:   time.Now(),
+		RoundNumber: roundNumber,
+		TableNumber: 0, // Not applicable for bye requests
+		Details:     detailJSON,
+	})
+	return t.SetEvents(events)
+}
 
-			// Update Head-to-Head results
-			if playerA, ok := playerIndex[m.PlayerA_ID]; ok {
-				playerA.HeadToHeadResults[m.PlayerB_ID] = m.ScoreA
-			}
-			if playerB, ok := playerIndex[m.PlayerB_ID]; ok {
-				playerB.HeadToHeadResults[m.PlayerA_ID] = m.ScoreB
-			}
+// colorPreference returns +1 when p should get White next, -1 when p should get Black,
+// and 0 when either color is acceptable. Two equal colors in a row, or more games with
+// one color than the other, produce a strong preference for the opposite color.
+func colorPreference(p *model.Player) int {
+	h := p.ColorHistory
+	n := len(h)
+	if n >= 2 && h[n-1] == h[n-2] {
+		if h[n-1] == 'W' {
+			return -1
 		}
+		return 1
+	}
+	whites := strings.Count(h, "W")
+	blacks := strings.Count(h, "B")
+	if whites > blacks {
+		return -1
+	}
+	if blacks > whites {
+		return 1
+	}
+	return 0
+}
 
-		// Update Progressive Score after this round
-		for i := range players {
-			p := &players[i]
-			if player, ok := playerIndex[p.ID]; ok {
-				// Add current round score to progressive total
-				roundScore := 0.0
-				for _, m := range currentRound.Matches {
-					if m.Result == "" {
-						continue
-					}
-					if m.PlayerA_ID == p.ID {
-						roundScore = m.ScoreA
-						break
-					} else if m.PlayerB_ID == p.ID {
-						roundScore = m.ScoreB
-						break
-					}
-				}
-				player.ProgressiveScore += roundScore
-			}
-		}
+// lastTwoSame reports whether the player's two most recent colors are equal,
+// meaning another game with that color would be a third in a row.
+func lastTwoSame(p *model.Player) bool {
+	n := len(p.ColorHistory)
+	return n >= 2 && p.ColorHistory[n-1] == p.ColorHistory[n-2]
+}
+
+// colorConflict reports whether pairing a and b forces one of them into a third consecutive same color.
+func colorConflict(a, b *model.Player) bool {
+	return lastTwoSame(a) && lastTwoSame(b) && colorPreference(a) == colorPreference(b)
+}
+
+// assignColors decides who plays White. Strong preferences win; when both players want
+// the same color, the one at risk of a third same color in a row gets it, then the one
+// with the larger color imbalance. Otherwise colors simply alternate from Player A's last game.
+func assignColors(a, b *model.Player) (white, black *model.Player) {
+	pa, pb := colorPreference(a), colorPreference(b)
+	if pa > pb {
+		return a, b
+	}
+	if pb > pa {
+		return b, a
 	}
 
-	for i := range players {
-		sum := 0.0
-		for _, oid := range players[i].OpponentIDs {
-			// Skip bye opponent for Buchholz
-			if oid == ByePlayerID {
-				continue
-			}
-			sum += scoreIndex[oid]
+	if pa != 0 {
+		// Both want the same color: decide who has the stronger claim
+		aClaim, bClaim := lastTwoSame(a), lastTwoSame(b)
+		if aClaim == bClaim {
+			aImbalance := strings.Count(a.ColorHistory, "W") - strings.Count(a.ColorHistory, "B")
+			bImbalance := strings.Count(b.ColorHistory, "W") - strings.Count(b.ColorHistory, "B")
+			aClaim = aImbalance*pa < bImbalance*pb
+			bClaim = !aClaim
 		}
-		players[i].Buchholz = sum
+		if aClaim != (pa > 0) {
+			return b, a
+		}
+		return a, b
 	}
 
-	return t.SetPlayers(players)
+	if len(a.ColorHistory) > 0 && a.ColorHistory[len(a.ColorHistory)-1] == 'W' {
+		return b, a
+	}
+	return a, b
 }
 
-// GetStandings returns the players sorted by: Score desc, Head-to-Head, Buchholz desc, Progressive Score desc, Name asc.
-// It recomputes all tie-breakers before sorting to ensure they are up-to-date.
-func GetStandings(t *model.Tournament) ([]model.Player, error) {
-	if err := UpdateStandings(t); err != nil {
+// GetColorBalance returns, per player ID, the number of Whites minus Blacks in their
+// ColorHistory. Players at +2/-2 or beyond should be evened out next round.
+func GetColorBalance(t *model.Tournament) (map[string]int, error) {
+	players, err := t.GetPlayers()
+	if err != nil {
 		return nil, err
 	}
+	balance := make(map[string]int, len(players))
+	for _, p := range players {
+		balance[p.ID] = strings.Count(p.ColorHistory, "W") - strings.Count(p.ColorHistory, "B")
+	}
+	return balance, nil
+}
+
+// GetColorsDue returns, per player ID, the color the player is due next round: "W", "B",
+// or "" when either color is fine. It follows the preference used for pairing colors.
+func GetColorsDue(t *model.Tournament) (map[string]string, error) {
 	players, err := t.GetPlayers()
 	if err != nil {
 		return nil, err
 	}
-	sort.SliceStable(players, func(i, j int) bool {
-		// 1. Total Points (Score) - highest first
-		if players[i].Score != players[j].Score {
-			return players[i].Score > players[j].Score
-		}
-		
-		// 2. Head-to-Head - check if they played against each other
-		if h2hResult, exists := players[i].HeadToHeadResults[players[j].ID]; exists {
-			if h2hOpponentResult, opponentExists := players[j].HeadToHeadResults[players[i].ID]; opponentExists {
-				// If they played each other, use head-to-head result
-				if h2hResult != h2hOpponentResult {
-					return h2hResult > h2hOpponentResult
-				}
-			}
-		}
-		
-		// 3. Buchholz - highest first
-		if players[i].Buchholz != players[j].Buchholz {
-			return players[i].Buchholz > players[j].Buchholz
-		}
-		
-		// 4. Progressive Score - highest first
-		if players[i].ProgressiveScore != players[j].ProgressiveScore {
-			return players[i].ProgressiveScore > players[j].ProgressiveScore
+	due := make(map[string]string, len(players))
+	for i := range players {
+		switch colorPreference(&players[i]) {
+		case 1:
+			due[players[i].ID] = "W"
+		case -1:
+			due[players[i].ID] = "B"
+		default:
+			due[players[i].ID] = ""
 		}
-		
-		// 5. Name - alphabetical order
-		return players[i].Name < players[j].Name
-	})
-	return players, nil
+	}
+	return due, nil
 }
 
-// AdvanceToNextRound runs the pairing engine for the next round and persists the round.
-// It updates CurrentRound and TotalPlayers on the tournament.
-func AdvanceToNextRound(t *model.Tournament, engine PairingEngine) error {
+// RemainingPairings counts the pairs of active (not withdrawn) players who have not played
+// each other yet; forbidden pairs are counted as played. Each remaining round of an even
+// field needs n/2 of them, so a low count warns that rematches are coming.
+func RemainingPairings(t *model.Tournament) (int, error) {
 	players, err := t.GetPlayers()
 	if err != nil {
-		return err
+		return 0, err
 	}
+	players = activePlayers(players)
+	forbidden := forbiddenPairSet(t)
 
-	// Prevent advancing if the current round exists and is not complete
-	if t.CurrentRound > 0 {
-		rounds, err2 := t.GetRounds()
-		if err2 != nil {
-			return err2
+	remaining := 0
+	for i := range players {
+		played := make(map[string]bool, len(players[i].OpponentIDs))
+		for _, oid := range players[i].OpponentIDs {
+			played[oid] = true
+		}
+		for j := i + 1; j < len(players); j++ {
+			if !played[players[j].ID] && !forbidden[[2]string{players[i].ID, players[j].ID}] {
+				remaining++
+			}
 		}
+	}
+	return remaining, nil
+}
 
-		for _, r := range rounds {
-			if r.RoundNumber == t.CurrentRound {
-				if !r.IsComplete {
-					// Collect detailed information about incomplete matches
-					var incompleteMatches []string
-					var totalMatches int
-					var completedMatches int
+// acceleratedGroupSize returns how many players (the lowest start ranks) receive
+// the virtual point in accelerated rounds: half the field rounded up to an even number,
+// so the top group can always be paired within itself.
+func acceleratedGroupSize(n int) int {
+	size := 2 * ((n + 3) / 4)
+	if size > n {
+		size = n
+	}
+	return size
+}
 
-					for _, m := range r.Matches {
+// RecommendRounds returns the number of Swiss rounds needed to produce a clear winner:
+// ceil(log2(playerCount)), but at least 3.
+func RecommendRounds(playerCount int) int {
+	rounds := 0
+	for n := 1; n < playerCount; n *= 2 {
+		rounds++
+	}
+	if rounds < 3 {
+		return 3
+	}
+	return rounds
+}
+
+// SetRoundsTotal changes the number of rounds of a Swiss or team event, e.g. to extend it
+// beyond the recommended default. It cannot go below the current round; extending a complete
+// tournament reopens it. Round robin schedules and knockouts set their own length.
+func SetRoundsTotal(t *model.Tournament, rounds int) error {
+	if t.PairingSystem == PairingSystemRoundRobin || t.PairingSystem == PairingSystemKnockout {
+		return fmt.Errorf("the number of rounds of a %s event follows from its format", t.PairingSystem)
+	}
+	if rounds < 1 {
+		return fmt.Errorf("a tournament needs at least 1 round, got %d", rounds)
+	}
+	if rounds < t.CurrentRound {
+		return fmt.Errorf("cannot set %d rounds: round %d has already been paired", rounds, t.CurrentRound)
+	}
+	if t.Status == StatusComplete && rounds > t.CurrentRound {
+		if err := ReopenTournament(t); err != nil {
+			return err
+		}
+	}
+	t.RoundsTotal = rounds
+	return nil
+}
+
+// ensureMoreRounds checks that another round may be paired: the tournament is not complete
+// and RoundsTotal (when set) has not been reached.
+func ensureMoreRounds(t *model.Tournament) error {
+	if t.Status == StatusComplete {
+		return fmt.Errorf("cannot advance: tournament is complete")
+	}
+	if t.RoundsTotal > 0 && t.CurrentRound >= t.RoundsTotal {
+		return fmt.Errorf("cannot advance: all %d rounds have been paired", t.RoundsTotal)
+	}
+	return nil
+}
+
+// InitializeTournament sets minimal fields and attaches players.
+// Title is required; players will be serialized into PlayersData.
+// PairingSystem defaults to "SWISS"; ByeScore defaults to 1.0 if unset.
+func InitializeTournament(t *model.Tournament, title string, description string, players []model.Player) error {
+	// Validate required fields
+	if strings.TrimSpace(title) == "" {
+		return fmt.Errorf("field must be filled: Title is required")
+	}
+	if strings.TrimSpace(description) == "" {
+		return fmt.Errorf("field must be filled: Description is required")
+	}
+	// Every pairing system pairs players against each other
+	if len(players) == 0 {
+		return fmt.Errorf("field must be filled: at least one player is required")
+	}
+
+	t.Title = title
+	t.Description = description
+	t.Status = StatusSetup // ACTIVE once the first round is generated
+	t.StartTime = time.Now()
+	t.CurrentRound = 0
+	t.TotalPlayers = len(players)
+	if t.PairingSystem == "" {
+		t.PairingSystem = PairingSystemSwiss
+	}
+	if t.ByeScore == 0 {
+		t.ByeScore = 1.0
+	}
+	if t.PointsWin == 0 {
+		t.PointsWin, t.PointsDraw, t.PointsLoss = 1.0, 0.5, 0.0
+	}
+	if t.ByePolicy == "" {
+		t.ByePolicy = ByePolicyLowest
+	}
+	// Forfeits count in tie-breaks unless strict FIDE mode is chosen later. The default is set
+	// here rather than as a column default, which would make false impossible to store.
+	t.CountForfeitsInTiebreaks = true
+	// Knockout brackets end on their own once a champion is decided
+	if t.RoundsTotal == 0 && t.PairingSystem == PairingSystemRoundRobin {
+		t.RoundsTotal = RoundRobinRounds(t, len(players))
+	} else if t.RoundsTotal == 0 && t.PairingSystem != PairingSystemKnockout {
+		t.RoundsTotal = RecommendRounds(len(players))
+	}
+
+	// Seed the field by rating
+	assignStartRanks(players)
+
+	// Persist players
+	if err := t.SetPlayers(players); err != nil {
+		return err
+	}
+
+	// Initialize empty rounds
+	if err := t.SetRounds([]model.Round{}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// assignStartRanks numbers the players 1..n by Rating (highest first, unrated last), ties
+// by Name. The slice keeps its order; only StartRank is set.
+func assignStartRanks(players []model.Player) {
+	order := make([]int, len(players))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		a, b := players[order[i]], players[order[j]]
+		if a.Rating != b.Rating {
+			return a.Rating > b.Rating
+		}
+		return a.Name < b.Name
+	})
+	for rank, i := range order {
+		players[i].StartRank = rank + 1
+	}
+}
+
+// startRanks maps player IDs to their StartRank. Tournaments created before start ranks
+// existed (any player without one) fall back to the order of PlayersData.
+func startRanks(players []model.Player) map[string]int {
+	ranks := make(map[string]int, len(players))
+	for _, p := range players {
+		if p.StartRank == 0 {
+			for i, q := range players {
+				ranks[q.ID] = i + 1
+			}
+			return ranks
+		}
+		ranks[p.ID] = p.StartRank
+	}
+	return ranks
+}
+
+// nextStartRank returns the start rank for a player added after the field was seeded.
+func nextStartRank(players []model.Player) int {
+	next := len(players) + 1
+	for _, p := range players {
+		if p.StartRank >= next {
+			next = p.StartRank + 1
+		}
+	}
+	return next
+}
+
+// findMatchIn locates a match by round and table within rounds. The returned pointers point
+// into rounds, so changes are kept when rounds is saved back with SetRounds.
+func findMatchIn(rounds []model.Round, roundNumber int, tableNumber int) (*model.Match, *model.Round, error) {
+	for r := range rounds {
+		if rounds[r].RoundNumber != roundNumber {
+			continue
+		}
+		for m := range rounds[r].Matches {
+			if rounds[r].Matches[m].TableNumber == tableNumber {
+				return &rounds[r].Matches[m], &rounds[r], nil
+			}
+		}
+		return nil, nil, fmt.Errorf("match not found for round %d, table %d", roundNumber, tableNumber)
+	}
+	return nil, nil, fmt.Errorf("round %d not found", roundNumber)
+}
+
+// FindMatch returns the match played at a table in a round, along with its round.
+// The pointers refer to a fresh copy of the rounds; use the mutation functions to change results.
+func FindMatch(t *model.Tournament, roundNumber int, tableNumber int) (*model.Match, *model.Round, error) {
+	rounds, err := t.GetRounds()
+	if err != nil {
+		return nil, nil, err
+	}
+	return findMatchIn(rounds, roundNumber, tableNumber)
+}
+
+// MatchDetail is a match with its players' names resolved.
+type MatchDetail struct {
+	model.Match
+	PlayerAName string `json:"player_a_name"`
+	PlayerBName string `json:"player_b_name"`
+	WhiteName   string `json:"white_name,omitempty"`
+	BlackName   string `json:"black_name,omitempty"`
+}
+
+// GetMatch returns the current state of a single match with player names resolved.
+func GetMatch(t *model.Tournament, roundNumber int, tableNumber int) (MatchDetail, error) {
+	match, _, err := FindMatch(t, roundNumber, tableNumber)
+	if err != nil {
+		return MatchDetail{}, err
+	}
+	players, err := t.GetPlayers()
+	if err != nil {
+		return MatchDetail{}, err
+	}
+
+	name := func(id string) string {
+		if id == "" {
+			return ""
+		}
+		return getPlayerName(players, id)
+	}
+	return MatchDetail{
+		Match:       *match,
+		PlayerAName: name(match.PlayerA_ID),
+		PlayerBName: name(match.PlayerB_ID),
+		WhiteName:   name(match.WhiteID),
+		BlackName:   name(match.BlackID),
+	}, nil
+}
+
+// RecordMatchResult updates the specified match result and player standings.
+// result must be one of: "A_WIN", "B_WIN", "DRAW", "BYE_A", "BYE_B",
+// "A_FORFEIT" (Player A did not show), "B_FORFEIT" or "DOUBLE_FORFEIT" (see ValidResultsFor).
+func RecordMatchResult(t *model.Tournament, roundNumber int, tableNumber int, result model.MatchResult) error {
+	// Only the current round and earlier rounds may be edited; later rounds are ignored by
+	// RecomputePlayersFromRounds, so a result there would silently be lost from the standings.
+	if roundNumber < 1 || roundNumber > t.CurrentRound {
+		return fmt.Errorf("cannot record result for round %d: current round is %d", roundNumber, t.CurrentRound)
+	}
+	if err := ensureNotComplete(t); err != nil {
+		return err
+	}
+
+	rounds, err := t.GetRounds()
+	if err != nil {
+		return err
+	}
+
+	// Locate the target match and round
+	match, targetRound, err := findMatchIn(rounds, roundNumber, tableNumber)
+	if err != nil {
+		return err
+	}
+
+	// Validate BYE consistency
+	if result == model.ResultByeA && match.PlayerB_ID != ByePlayerID {
+		return fmt.Errorf("invalid result BYE_A for non-bye match at round %d, table %d", roundNumber, tableNumber)
+	}
+	if result == model.ResultByeB && match.PlayerA_ID != ByePlayerID {
+		return fmt.Errorf("invalid result BYE_B for non-bye match at round %d, table %d", roundNumber, tableNumber)
+	}
+	if isForfeitResult(result) && isBye(*match) {
+		return fmt.Errorf("invalid result %s for bye match at round %d, table %d", result, roundNumber, tableNumber)
+	}
+
+	// Keep the match as it was so the change can be undone
+	previous := *match
+
+	// Overwrite match result and scores (supports resubmission safely)
+	match.Forfeit = isForfeitResult(result)
+	switch result {
+	case model.ResultAWin, model.ResultBWin, model.ResultDraw, model.ResultAForfeit, model.ResultBForfeit, model.ResultDoubleForfeit:
+		match.Result = result
+		match.ScoreA, match.ScoreB, _ = gameScores(t, result)
+	case model.ResultByeA:
+		match.Result = model.ResultByeA
+		match.ScoreA = byeValue(t, match, match.PlayerA_ID)
+		match.ScoreB = 0.0
+	case model.ResultByeB:
+		match.Result = model.ResultByeB
+		match.ScoreA = 0.0
+		match.ScoreB = byeValue(t, match, match.PlayerB_ID)
+	default:
+		return fmt.Errorf("unknown result %q", result)
+	}
+	recordedAt := time.Now()
+	match.ResultRecordedAt = &recordedAt
+
+	// Check if all matches in this round are now complete
+	wasComplete := targetRound.IsComplete
+	allComplete := true
+	for _, m := range targetRound.Matches {
+		if m.Result == "" {
+			allComplete = false
+			break
+		}
+	}
+	targetRound.IsComplete = allComplete
+
+	// Persist updated rounds before recompute
+	if err := t.SetRounds(rounds); err != nil {
+		return err
+	}
+
+	// Recompute all players (Score, ColorHistory, HasBye, OpponentIDs) from all recorded matches
+	if err := RecomputePlayersFromRounds(t); err != nil {
+		return err
+	}
+
+	// Append event: MATCH_RESULT_RECORDED with match snapshots before and after, or
+	// RESULT_CHANGED when a result was already recorded. Earlier events are kept as the
+	// correction trail; players were recomputed from the rounds, so nothing is counted twice.
+	events, _ := t.GetEvents()
+	eventType := "MATCH_RESULT_RECORDED"
+	if previous.Result != "" {
+		eventType = "RESULT_CHANGED"
+	}
+	detail := struct {
+		Match     model.Match       `json:"match"`
+		Previous  model.Match       `json:"previous"`
+		OldResult model.MatchResult `json:"old_result,omitempty"`
+		NewResult model.MatchResult `json:"new_result"`
+	}{
+		Match:     *match,
+		Previous:  previous,
+		OldResult: previous.Result,
+		NewResult: match.Result,
+	}
+	detailJSON, _ := json.Marshal(detail)
+	events = append(events, model.Event{
+		EventID:     uuid.New(),
+		Type:        eventType,
+		Timestamp:   time.Now(),
+		RoundNumber: roundNumber,
+		TableNumber: tableNumber,
+		Details:     detailJSON,
+	})
+
+	// Append event: ROUND_COMPLETED when this result finished the round
+	if allComplete && !wasComplete {
+		events = append(events, model.Event{
+			EventID:     uuid.New(),
+			Type:        "ROUND_COMPLETED",
+			Timestamp:   time.Now(),
+			RoundNumber: roundNumber,
+			TableNumber: 0, // Not applicable for round-level events
+		})
+	}
+	if err := t.SetEvents(events); err != nil {
+		return err
+	}
+	clearRedo(t)
+
+	// Completing the final round completes the tournament
+	if allComplete && t.Status == StatusActive && t.RoundsTotal > 0 && roundNumber == t.RoundsTotal {
+		if err := SetStatus(t, StatusComplete); err != nil {
+			return err
+		}
+		now := time.Now()
+		t.EndTime = &now
+	}
+
+	// Recompute standings (including Buchholz)
+	UpdateStandings(t)
+
+	return nil
+}
+
+func ensureOpponent(p *model.Player, oid string) {
+	for _, id := range p.OpponentIDs {
+		if id == oid {
+			return
+		}
+	}
+	p.OpponentIDs = append(p.OpponentIDs, oid)
+}
+
+// UpdateStandings recomputes Buchholz, its cut-1/cut-2 variants, Sonneborn-Berger and the
+// average opponent rating for all players and persists them.
+// Score, Progressive Score and Head-to-Head are rebuilt by RecomputePlayersFromRounds.
+func UpdateStandings(t *model.Tournament) error {
+	players, err := t.GetPlayers()
+	if err != nil {
+		return err
+	}
+	if err := computeTieBreaks(t, players); err != nil {
+		return err
+	}
+	return t.SetPlayers(players)
+}
+
+// computeTieBreaks fills in the tie-break fields of players in place, without persisting them.
+func computeTieBreaks(t *model.Tournament, players []model.Player) error {
+	// Build score and rating indexes
+	scoreIndex := make(map[string]float64, len(players))
+	ratingIndex := make(map[string]int, len(players))
+	for _, p := range players {
+		scoreIndex[p.ID] = p.Score
+		ratingIndex[p.ID] = p.Rating
+	}
+
+	var virtualScores map[string][]float64
+	if t.UseFIDEBuchholz {
+		var err error
+		virtualScores, err = virtualOpponentScores(t)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Strict FIDE mode: games lost or won by forfeit do not feed Buchholz or Sonneborn-Berger.
+	// With the virtual opponent a forfeit is an unplayed round, so its real opponent is left
+	// out of Buchholz either way (the virtual opponent stands in for it).
+	var forfeits map[[2]string]forfeitTally
+	if !t.CountForfeitsInTiebreaks || t.UseFIDEBuchholz {
+		var err error
+		forfeits, err = forfeitTallies(t, players)
+		if err != nil {
+			return err
+		}
+	}
+
+	for i := range players {
+		opponentScores := make([]float64, 0, len(players[i].OpponentIDs))
+		for _, oid := range players[i].OpponentIDs {
+			// Skip bye opponent for Buchholz
+			if oid == ByePlayerID {
+				continue
+			}
+			if f := forfeits[[2]string{players[i].ID, oid}]; f.forfeits > 0 && f.forfeits == f.games {
+				continue
+			}
+			opponentScores = append(opponentScores, scoreIndex[oid])
+		}
+		// FIDE: unplayed rounds count against a virtual opponent instead of being left out
+		opponentScores = append(opponentScores, virtualScores[players[i].ID]...)
+		sort.Float64s(opponentScores)
+
+		players[i].Buchholz = sumFrom(opponentScores, 0)
+		players[i].BuchholzCut1 = sumFrom(opponentScores, 1)
+		players[i].BuchholzCut2 = sumFrom(opponentScores, 2)
+
+		// Sonneborn-Berger: full opponent score for each win, half for each draw
+		sb := 0.0
+		for oid, pts := range players[i].HeadToHeadResults {
+			if !t.CountForfeitsInTiebreaks {
+				pts -= forfeits[[2]string{players[i].ID, oid}].points
+			}
+			sb += pts * scoreIndex[oid]
+		}
+		players[i].SonnebornBerger = sb
+
+		// Average opponent rating: byes and unrated opponents are left out
+		ratingSum, rated := 0, 0
+		for _, oid := range players[i].OpponentIDs {
+			if r := ratingIndex[oid]; r > 0 {
+				ratingSum += r
+				rated++
+			}
+		}
+		players[i].AvgOpponentRating = 0
+		if rated > 0 {
+			players[i].AvgOpponentRating = float64(ratingSum) / float64(rated)
+		}
+	}
+
+	return nil
+}
+
+// forfeitTally counts a player's games with recorded results against one opponent, how many of
+// them were forfeits and the points the player got from those forfeits.
+type forfeitTally struct {
+	games    int
+	forfeits int
+	points   float64
+}
+
+// forfeitTallies returns the forfeitTally of every {player, opponent} pair over the games that
+// count toward the standings (see RecomputePlayersFromRounds).
+func forfeitTallies(t *model.Tournament, players []model.Player) (map[[2]string]forfeitTally, error) {
+	rounds, err := t.GetRounds()
+	if err != nil {
+		return nil, err
+	}
+	withdrawnIn := make(map[string]int, len(players))
+	for _, p := range players {
+		if p.Withdrawn {
+			withdrawnIn[p.ID] = p.WithdrawnInRound
+		}
+	}
+	counts := func(m model.Match) bool {
+		for _, id := range []string{m.PlayerA_ID, m.PlayerB_ID} {
+			if round, ok := withdrawnIn[id]; ok && m.RoundNumber > round {
+				return false
+			}
+		}
+		return m.Result != "" && !isBye(m)
+	}
+
+	tallies := make(map[[2]string]forfeitTally)
+	for _, r := range rounds {
+		if r.RoundNumber > t.CurrentRound {
+			continue
+		}
+		for _, m := range r.Matches {
+			if !counts(m) {
+				continue
+			}
+			for _, side := range []struct {
+				player, opponent string
+				score            float64
+			}{{m.PlayerA_ID, m.PlayerB_ID, m.ScoreA}, {m.PlayerB_ID, m.PlayerA_ID, m.ScoreB}} {
+				key := [2]string{side.player, side.opponent}
+				tally := tallies[key]
+				tally.games++
+				if m.Forfeit {
+					tally.forfeits++
+					tally.points += side.score
+				}
+				tallies[key] = tally
+			}
+		}
+	}
+	return tallies, nil
+}
+
+// virtualOpponentScores returns, per player, the FIDE virtual opponent score for each of their
+// unplayed rounds (byes, forfeits, or rounds they were not paired in). For round R of n played rounds the
+// virtual opponent scores SPR + (win - SfB) + draw*(n - R), where SPR is the player's score before
+// round R and SfB the points the player received in it.
+func virtualOpponentScores(t *model.Tournament) (map[string][]float64, error) {
+	players, err := t.GetPlayers()
+	if err != nil {
+		return nil, err
+	}
+	rounds, err := t.GetRounds()
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(rounds, func(i, j int) bool {
+		return rounds[i].RoundNumber < rounds[j].RoundNumber
+	})
+
+	win, draw, _ := pointsSystem(t)
+	n := t.CurrentRound
+	running := make(map[string]float64, len(players))
+	result := make(map[string][]float64)
+
+	for _, r := range rounds {
+		if r.RoundNumber < 1 || r.RoundNumber > n {
+			continue
+		}
+
+		// Points received this round, and who played a real game
+		received := make(map[string]float64, len(players))
+		played := make(map[string]bool, len(players))
+		for _, m := range r.Matches {
+			if isBye(m) {
+				recipient := byeRecipient(m)
+				if m.Result == "" {
+					// Unrecorded bye: nothing received yet, but still unplayed
+					continue
+				}
+				if m.PlayerB_ID == recipient {
+					received[recipient] = m.ScoreB
+				} else {
+					received[recipient] = m.ScoreA
+				}
+				continue
+			}
+			// FIDE counts a forfeit as an unplayed round for both players
+			if !m.Forfeit {
+				played[m.PlayerA_ID] = true
+				played[m.PlayerB_ID] = true
+			}
+			if m.Result != "" {
+				received[m.PlayerA_ID] = m.ScoreA
+				received[m.PlayerB_ID] = m.ScoreB
+			}
+		}
+
+		for _, p := range players {
+			if !played[p.ID] {
+				virtual := running[p.ID] + (win - received[p.ID]) + draw*float64(n-r.RoundNumber)
+				result[p.ID] = append(result[p.ID], virtual)
+			}
+			running[p.ID] += received[p.ID]
+		}
+	}
+
+	return result, nil
+}
+
+// sumFrom sums the values of a sorted slice, skipping the first skip entries.
+func sumFrom(values []float64, skip int) float64 {
+	sum := 0.0
+	for i := skip; i < len(values); i++ {
+		sum += values[i]
+	}
+	return sum
+}
+
+// compareStandings compares two players by Score, then by the tie-breaks in order.
+// It returns a positive value when a ranks above b, negative when below and 0 when fully tied.
+func compareStandings(order []string, a, b *model.Player) float64 {
+	if a.Score != b.Score {
+		return a.Score - b.Score
+	}
+	for _, name := range order {
+		compare, ok := tieBreakRegistry[TieBreak(name)]
+		if !ok {
+			continue
+		}
+		if diff := compare(a, b); diff != 0 {
+			return diff
+		}
+	}
+	return 0
+}
+
+// GetStandings returns the players sorted by Score desc, then the tournament's TieBreakOrder
+// (default: Head-to-Head, Buchholz, Progressive Score), then Name asc.
+// Tie-breakers are recomputed on a copy before sorting; the tournament is left untouched.
+func GetStandings(t *model.Tournament) ([]model.Player, error) {
+	players, err := t.GetPlayers()
+	if err != nil {
+		return nil, err
+	}
+	// Tie-breaks are computed on the copy only; UpdateStandings persists them
+	if err := computeTieBreaks(t, players); err != nil {
+		return nil, err
+	}
+
+	order := tieBreakOrder(t)
+	sort.SliceStable(players, func(i, j int) bool {
+		// 1. Total Points (Score), then 2. the configured tie-breaks - highest first
+		if diff := compareStandings(order, &players[i], &players[j]); diff != 0 {
+			return diff > 0
+		}
+
+		// 3. Name - alphabetical order
+		return players[i].Name < players[j].Name
+	})
+
+	// A decided tiebreak match settles the order of two players level on points
+	applyTiebreakWinners(players, tiebreakWinners(t))
+	return players, nil
+}
+
+// GetPodium returns the top three of the standings, or fewer in a small field. Players fully tied
+// with third place (same score and tie-breaks) are all included. Until a round is complete the
+// order is meaningless, so an empty slice is returned.
+func GetPodium(t *model.Tournament) ([]model.Player, error) {
+	rounds, err := t.GetRounds()
+	if err != nil {
+		return nil, err
+	}
+	anyComplete := false
+	for _, r := range rounds {
+		if r.RoundNumber <= t.CurrentRound && r.IsComplete {
+			anyComplete = true
+			break
+		}
+	}
+	if !anyComplete {
+		return []model.Player{}, nil
+	}
+
+	standings, err := GetStandings(t)
+	if err != nil {
+		return nil, err
+	}
+	if len(standings) <= 3 {
+		return standings, nil
+	}
+
+	order := tieBreakOrder(t)
+	tiebreaks := tiebreakWinners(t)
+	end := 3
+	for end < len(standings) && compareStandings(order, &standings[2], &standings[end]) == 0 &&
+		!wonTiebreak(tiebreaks, standings[2].ID, standings[end].ID) {
+		end++
+	}
+	return standings[:end], nil
+}
+
+// ProjectStandings returns the standings as they would be if the current round's games at the
+// given tables ended with the given results (table number -> result code). The results are
+// recorded on a copy of the tournament; t itself is never changed.
+func ProjectStandings(t *model.Tournament, hypothetical map[int]model.MatchResult) ([]model.Player, error) {
+	if t.CurrentRound == 0 {
+		return nil, fmt.Errorf("no round has been paired yet")
+	}
+
+	// Work on a copy: every setter replaces the JSON blobs instead of writing into them
+	projection := *t
+	tables := make([]int, 0, len(hypothetical))
+	for table := range hypothetical {
+		tables = append(tables, table)
+	}
+	sort.Ints(tables)
+	for _, table := range tables {
+		if err := RecordMatchResult(&projection, t.CurrentRound, table, hypothetical[table]); err != nil {
+			return nil, err
+		}
+	}
+	return GetStandings(&projection)
+}
+
+// PlayerStandingWithDelta is a standings line with the player's rank after the previous round.
+type PlayerStandingWithDelta struct {
+	model.Player
+	Rank         int `json:"rank"`
+	PreviousRank int `json:"previous_rank"`
+	Movement     int `json:"movement"` // PreviousRank - Rank: positive moved up, negative moved down
+}
+
+// GetStandingsWithMovement returns the standings with each player's rank after the previous
+// round, recomputed on a copy of the tournament as of CurrentRound - 1. Until round 2 every
+// movement is 0.
+func GetStandingsWithMovement(t *model.Tournament) ([]PlayerStandingWithDelta, error) {
+	standings, err := GetStandings(t)
+	if err != nil {
+		return nil, err
+	}
+
+	previousRanks := make(map[string]int, len(standings))
+	if t.CurrentRound > 1 {
+		previous := *t
+		previous.CurrentRound = t.CurrentRound - 1
+		if err := RecomputePlayersFromRounds(&previous); err != nil {
+			return nil, err
+		}
+		previousStandings, err := GetStandings(&previous)
+		if err != nil {
+			return nil, err
+		}
+		for i, p := range previousStandings {
+			previousRanks[p.ID] = i + 1
+		}
+	}
+
+	result := make([]PlayerStandingWithDelta, 0, len(standings))
+	for i, p := range standings {
+		entry := PlayerStandingWithDelta{Player: p, Rank: i + 1, PreviousRank: i + 1}
+		if rank, ok := previousRanks[p.ID]; ok {
+			entry.PreviousRank = rank
+		}
+		entry.Movement = entry.PreviousRank - entry.Rank
+		result = append(result, entry)
+	}
+	return result, nil
+}
+
+// ensureCurrentRoundComplete returns a descriptive error listing unfinished tables
+// when the current round exists and is not complete yet.
+func ensureCurrentRoundComplete(t *model.Tournament, players []model.Player) error {
+	if t.CurrentRound > 0 {
+		rounds, err := t.GetRounds()
+		if err != nil {
+			return err
+		}
+
+		for _, r := range rounds {
+			if r.RoundNumber == t.CurrentRound {
+				if !r.IsComplete {
+					// Collect detailed information about incomplete matches
+					var incompleteMatches []string
+					var totalMatches int
+					var completedMatches int
+
+					for _, m := range r.Matches {
 						totalMatches++
 						if m.Result == "" {
 							// Format player names for better readability
 							playerAName := getPlayerName(players, m.PlayerA_ID)
 							playerBName := getPlayerName(players, m.PlayerB_ID)
 
-							if m.PlayerB_ID == ByePlayerID {
-								incompleteMatches = append(incompleteMatches,
-									fmt.Sprintf("Table %d: %s (BYE)", m.TableNumber, playerAName))
-							} else {
-								incompleteMatches = append(incompleteMatches,
-									fmt.Sprintf("Table %d: %s vs %s", m.TableNumber, playerAName, playerBName))
-							}
-						} else {
-							completedMatches++
-						}
-					}
+							if isBye(m) {
+								incompleteMatches = append(incompleteMatches,
+									fmt.Sprintf("Table %d: %s (BYE)", m.TableNumber, getPlayerName(players, byeRecipient(m))))
+							} else {
+								incompleteMatches = append(incompleteMatches,
+									fmt.Sprintf("Table %d: %s vs %s", m.TableNumber, playerAName, playerBName))
+							}
+						} else {
+							completedMatches++
+						}
+					}
+
+					// Build detailed error message
+					errorMsg := fmt.Sprintf("Cannot advance: Round %d is not complete (%d/%d matches finished).\n",
+						t.CurrentRound, completedMatches, totalMatches)
+
+					if len(incompleteMatches) > 0 {
+						errorMsg += "Incomplete matches:\n"
+						for _, match := range incompleteMatches {
+							errorMsg += "• " + match + "\n"
+						}
+						// Remove trailing newline
+						errorMsg = strings.TrimSuffix(errorMsg, "\n")
+					}
+
+					return fmt.Errorf("%s", errorMsg)
+				}
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// orderMatchesByTable sorts generated matches into their table order and renumbers them.
+func orderMatchesByTable(t *model.Tournament, players []model.Player, matches []model.Match) {
+	// A rating-paired first round is already in seed order, and its colors alternate by table
+	if t.CurrentRound == 0 && t.FirstRoundMethod == FirstRoundRating && !t.Accelerated {
+		return
+	}
+
+	// Reorder matches so the previous table-1 winner stays on table 1,
+	// BYE (if any) moves to last, and remaining matches follow standings.
+	// This prioritizes keeping table over keeping color.
+	// Helper: find previous round table-1 winner
+	prevTable1Winner := ""
+	if t.CurrentRound > 0 {
+		if rounds, rErr := t.GetRounds(); rErr == nil {
+			for _, r := range rounds {
+				if r.RoundNumber == t.CurrentRound {
+					for _, m := range r.Matches {
+						if m.TableNumber != 1 {
+							continue
+						}
+						switch m.Result {
+						case model.ResultAWin, model.ResultByeA, model.ResultBForfeit:
+							prevTable1Winner = m.PlayerA_ID
+						case model.ResultBWin, model.ResultByeB, model.ResultAForfeit:
+							prevTable1Winner = m.PlayerB_ID
+						default:
+							prevTable1Winner = "" // DRAW or empty result: no anchor
+						}
+						break
+					}
+					break
+				}
+			}
+		}
+	}
+	// Build standings rank map for fallback ordering
+	rank := map[string]int{}
+	if standings, sErr := GetStandings(t); sErr == nil {
+		for i := range standings {
+			rank[standings[i].ID] = i // smaller index => higher rank
+		}
+	}
+	// Helper: best rank involved in a match (BYE considered worst so it goes last)
+	bestRank := func(m model.Match) int {
+		if m.PlayerA_ID == ByePlayerID || m.PlayerB_ID == ByePlayerID {
+			return len(players) + 1
+		}
+		ra := rank[m.PlayerA_ID]
+		rb := rank[m.PlayerB_ID]
+		if ra < rb {
+			return ra
+		}
+		return rb
+	}
+	hasBye := func(m model.Match) bool {
+		return m.PlayerA_ID == ByePlayerID || m.PlayerB_ID == ByePlayerID
+	}
+	contains := func(m model.Match, id string) bool {
+		return id != "" && (m.PlayerA_ID == id || m.PlayerB_ID == id)
+	}
+
+	// Sort with priority:
+	// 1) match containing previous table-1 winner comes first
+	// 2) BYE matches go last
+	// 3) remaining matches ordered by standings (bestRank)
+	sort.SliceStable(matches, func(i, j int) bool {
+		iHasAnchor := contains(matches[i], prevTable1Winner)
+		jHasAnchor := contains(matches[j], prevTable1Winner)
+		if iHasAnchor != jHasAnchor {
+			return iHasAnchor
+		}
+		iBye := hasBye(matches[i])
+		jBye := hasBye(matches[j])
+		if iBye != jBye {
+			return !iBye
+		}
+		return bestRank(matches[i]) < bestRank(matches[j])
+	})
+	// Reassign table numbers after sorting
+	for i := range matches {
+		matches[i].TableNumber = i + 1
+	}
+}
+
+// AdvanceToNextRound runs the pairing engine for the next round and persists the round.
+// It updates CurrentRound and TotalPlayers on the tournament.
+func AdvanceToNextRound(t *model.Tournament, engine PairingEngine) error {
+	if err := ensureMoreRounds(t); err != nil {
+		return err
+	}
+
+	players, err := t.GetPlayers()
+	if err != nil {
+		return err
+	}
+
+	// Prevent advancing if the current round exists and is not complete
+	if err := ensureCurrentRoundComplete(t, players); err != nil {
+		return err
+	}
+
+	// Double-round events replay every odd round with colors reversed
+	if isReverseRoundDue(t) {
+		return GenerateReverseRound(t)
+	}
+
+	nextRoundNumber := t.CurrentRound + 1
+
+	var sizeWarnings []string
+	if nextRoundNumber == 1 {
+		if sizeWarnings, err = fieldSizeWarnings(t, players); err != nil {
+			return err
+		}
+	}
+
+	// Pass the tournament to the pairing engine for context
+	matches, warnings, err := generatePairings(engine, t, players, nextRoundNumber)
+	if err != nil {
+		return err
+	}
+	warnings = append(sizeWarnings, warnings...)
+
+	orderMatchesByTable(t, players, matches)
+	applyFirstRoundColors(t, players, matches)
+
+	rounds, err := t.GetRounds()
+	if err != nil {
+		return err
+	}
+
+	// Remove any existing rounds after the current round to ensure fresh pairing
+	// This handles the case where user went back to previous round and wants to regenerate
+	filteredRounds := make([]model.Round, 0, len(rounds))
+	for _, r := range rounds {
+		if r.RoundNumber <= t.CurrentRound {
+			filteredRounds = append(filteredRounds, r)
+		}
+	}
+	rounds = filteredRounds
+
+	pairedAt := time.Now()
+	newRound := model.Round{
+		RoundNumber:          nextRoundNumber,
+		Matches:              matches,
+		IsComplete:           false,
+		PairedAt:             &pairedAt,
+		RoundStartTime:       &pairedAt,
+		RoundDurationMinutes: t.RoundDurationMinutes,
+		PairingWarnings:      warnings,
+	}
+	rounds = append(rounds, newRound)
+
+	if err := t.SetRounds(rounds); err != nil {
+		return err
+	}
+
+	t.CurrentRound = nextRoundNumber
+	t.TotalPlayers = len(players)
+	if t.Status == StatusSetup {
+		if err := SetStatus(t, StatusActive); err != nil {
+			return err
+		}
+	}
+
+	if err := appendRoundStartedEvent(t, newRound); err != nil {
+		return err
+	}
+	// Record the seed behind a random first round so the exact pairing can be regenerated
+	if nextRoundNumber == 1 && t.PairingSeed != 0 {
+		if err := appendPairingSeedEvent(t); err != nil {
+			return err
+		}
+	}
+	clearRedo(t)
+
+	// Requested byes are pre-scored, so bring the standings up to date right away
+	for _, m := range matches {
+		if m.RequestedBye {
+			if err := RecomputePlayersFromRounds(t); err != nil {
+				return err
+			}
+			return UpdateStandings(t)
+		}
+	}
+
+	return nil
+}
+
+// ensurePairingSeed chooses the pairing seed of a tournament that has none yet.
+func ensurePairingSeed(t *model.Tournament) {
+	if t.PairingSeed == 0 {
+		t.PairingSeed = time.Now().UnixNano()
+	}
+}
+
+// LockPairingSeed chooses the round 1 pairing seed now, when none is set yet, and returns it.
+// PreviewNextRound never stores a seed, so calling this first makes the round 1 preview and
+// the round AdvanceToNextRound generates identical.
+func LockPairingSeed(t *model.Tournament) (int64, error) {
+	if t.CurrentRound > 0 {
+		return 0, fmt.Errorf("round 1 is already paired")
+	}
+	ensurePairingSeed(t)
+	return t.PairingSeed, nil
+}
+
+// appendPairingSeedEvent logs a PAIRING_SEED event with the tournament's pairing seed.
+func appendPairingSeedEvent(t *model.Tournament) error {
+	events, _ := t.GetEvents()
+	detail := struct {
+		Seed int64 `json:"seed"`
+	}{
+		Seed: t.PairingSeed,
+	}
+	detailJSON, _ := json.Marshal(detail)
+	events = append(events, model.Event{
+		EventID:     uuid.New(),
+		Type:        "PAIRING_SEED",
+		Timestamp:   time.Now(),
+		RoundNumber: 1,
+		TableNumber: 0, // Not applicable for round-level events
+		Details:     detailJSON,
+	})
+	return t.SetEvents(events)
+}
+
+// PreviewNextRound generates the pairings for the next round without saving them.
+// The returned round carries the same table order AdvanceToNextRound would produce,
+// while the tournament itself is left untouched. Before round 1 without a pairing seed the
+// preview draws with a temporary one; call LockPairingSeed first to pair what it shows.
+func PreviewNextRound(t *model.Tournament, engine PairingEngine) (model.Round, error) {
+	if err := ensureMoreRounds(t); err != nil {
+		return model.Round{}, err
+	}
+
+	// Work on a copy: ordering tables refreshes standings, which rewrites PlayersData, and a
+	// missing first-round seed is only chosen for the preview
+	preview := *t
+	if preview.CurrentRound == 0 {
+		ensurePairingSeed(&preview)
+	}
+
+	players, err := preview.GetPlayers()
+	if err != nil {
+		return model.Round{}, err
+	}
+
+	if err := ensureCurrentRoundComplete(&preview, players); err != nil {
+		return model.Round{}, err
+	}
+
+	nextRoundNumber := preview.CurrentRound + 1
+	var matches []model.Match
+	var warnings []string
+	if isReverseRoundDue(&preview) {
+		matches, err = reverseCurrentRoundMatches(&preview)
+	} else {
+		matches, warnings, err = generatePairings(engine, &preview, players, nextRoundNumber)
+		if err == nil {
+			orderMatchesByTable(&preview, players, matches)
+			applyFirstRoundColors(&preview, players, matches)
+		}
+	}
+	if err != nil {
+		return model.Round{}, err
+	}
+
+	return model.Round{
+		RoundNumber:     nextRoundNumber,
+		Matches:         matches,
+		IsComplete:      false,
+		PairingWarnings: warnings,
+	}, nil
+}
+
+// RepairRemainingMatches re-pairs the current round of a Swiss tournament among the players
+// whose games have no result yet (e.g. after a withdrawal), leaving every recorded result as
+// it is. The new games take over the freed tables, lowest first, and avoid rematches against
+// anyone already met, including this round's finished opponents. Logs ROUND_REPAIRED.
+func RepairRemainingMatches(t *model.Tournament, roundNumber int) error {
+	if roundNumber != t.CurrentRound || roundNumber < 1 {
+		return fmt.Errorf("only the current round (%d) can be re-paired", t.CurrentRound)
+	}
+	if err := ensureNotComplete(t); err != nil {
+		return err
+	}
+	if t.PairingSystem != "" && t.PairingSystem != PairingSystemSwiss {
+		return fmt.Errorf("remaining matches can only be re-paired in a Swiss tournament")
+	}
+	engine, err := PairingEngineFor(t.PairingSystem)
+	if err != nil {
+		return err
+	}
+
+	players, err := t.GetPlayers()
+	if err != nil {
+		return err
+	}
+	rounds, err := t.GetRounds()
+	if err != nil {
+		return err
+	}
+	var round *model.Round
+	for i := range rounds {
+		if rounds[i].RoundNumber == roundNumber {
+			round = &rounds[i]
+			break
+		}
+	}
+	if round == nil {
+		return fmt.Errorf("round %d not found", roundNumber)
+	}
+
+	// Freeze the finished games; the others release their players and tables
+	frozen := make([]model.Match, 0, len(round.Matches))
+	freedTables := []int{}
+	remaining := make(map[string]bool)
+	lastTable := 0
+	for _, m := range round.Matches {
+		if m.TableNumber > lastTable {
+			lastTable = m.TableNumber
+		}
+		if m.Result != "" {
+			frozen = append(frozen, m)
+			continue
+		}
+		freedTables = append(freedTables, m.TableNumber)
+		for _, id := range []string{m.PlayerA_ID, m.PlayerB_ID} {
+			if id != ByePlayerID {
+				remaining[id] = true
+			}
+		}
+	}
+	if len(freedTables) == 0 {
+		return fmt.Errorf("round %d has no unplayed matches", roundNumber)
+	}
+	sort.Ints(freedTables)
+
+	pool := []model.Player{}
+	for _, p := range players {
+		if remaining[p.ID] {
+			pool = append(pool, p)
+		}
+	}
+	matches := []model.Match{}
+	var warnings []string
+	if len(activePlayers(pool)) > 0 {
+		if matches, warnings, err = generatePairings(engine, t, pool, roundNumber); err != nil {
+			return err
+		}
+	}
+
+	tables := make([]int, 0, len(matches))
+	for i := range matches {
+		if i < len(freedTables) {
+			matches[i].TableNumber = freedTables[i]
+		} else {
+			lastTable++
+			matches[i].TableNumber = lastTable
+		}
+		tables = append(tables, matches[i].TableNumber)
+	}
+	round.Matches = append(frozen, matches...)
+	sort.SliceStable(round.Matches, func(i, j int) bool {
+		return round.Matches[i].TableNumber < round.Matches[j].TableNumber
+	})
+	round.PairingWarnings = append(round.PairingWarnings, warnings...)
+	round.IsComplete = len(round.Matches) > 0
+	for _, m := range round.Matches {
+		if m.Result == "" {
+			round.IsComplete = false
+			break
+		}
+	}
+	if err := t.SetRounds(rounds); err != nil {
+		return err
+	}
+	if err := RecomputePlayersFromRounds(t); err != nil {
+		return err
+	}
+	// Undone results may refer to the games that were just replaced
+	clearRedo(t)
+	if err := UpdateStandings(t); err != nil {
+		return err
+	}
+
+	events, _ := t.GetEvents()
+	detail := struct {
+		FreedTables []int `json:"freed_tables"`
+		Tables      []int `json:"tables"`
+	}{
+		FreedTables: freedTables,
+		Tables:      tables,
+	}
+	detailJSON, _ := json.Marshal(detail)
+	events = append(events, model.Event{
+		EventID:     uuid.New(),
+		Type:        "ROUND_REPAIRED",
+		Timestamp:   time.Now(),
+		RoundNumber: roundNumber,
+		TableNumber: 0, // Not applicable for round-level events
+		Details:     detailJSON,
+	})
+	return t.SetEvents(events)
+}
+
+// isReverseRoundDue reports whether the next round of a double-round event is the return game
+// of the current round (rounds 2, 4, 6, ... replay rounds 1, 3, 5, ... with colors reversed).
+func isReverseRoundDue(t *model.Tournament) bool {
+	return t.DoubleRound && t.CurrentRound%2 == 1
+}
+
+// reverseCurrentRoundMatches copies the current round's pairings with White and Black swapped.
+// Tables are kept; results, scores and float annotations are reset. Byes are repeated as-is.
+func reverseCurrentRoundMatches(t *model.Tournament) ([]model.Match, error) {
+	rounds, err := t.GetRounds()
+	if err != nil {
+		return nil, err
+	}
+
+	var current *model.Round
+	for r := range rounds {
+		if rounds[r].RoundNumber == t.CurrentRound {
+			current = &rounds[r]
+			break
+		}
+	}
+	if current == nil {
+		return nil, fmt.Errorf("current round %d not found in rounds data", t.CurrentRound)
+	}
+
+	matches := make([]model.Match, 0, len(current.Matches))
+	for _, m := range current.Matches {
+		reversed := model.Match{
+			RoundNumber: t.CurrentRound + 1,
+			TableNumber: m.TableNumber,
+			PlayerA_ID:  m.PlayerA_ID,
+			PlayerB_ID:  m.PlayerB_ID,
+			WhiteID:     m.BlackID,
+			BlackID:     m.WhiteID,
+			Result:      "",
+		}
+		if isBye(m) {
+			reversed.WhiteID = m.WhiteID
+			reversed.BlackID = m.BlackID
+		}
+		matches = append(matches, reversed)
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].TableNumber < matches[j].TableNumber
+	})
+	return matches, nil
+}
+
+// GenerateReverseRound appends the return round of a double-round event: the same pairings
+// as the current round with WhiteID and BlackID swapped. The repeated pairing is the only
+// rematch allowed; later rounds are paired by the engine with the usual no-rematch rule.
+func GenerateReverseRound(t *model.Tournament) error {
+	if t.CurrentRound <= 0 {
+		return fmt.Errorf("cannot generate reverse round: no round has been played yet")
+	}
+
+	players, err := t.GetPlayers()
+	if err != nil {
+		return err
+	}
+
+	if err := ensureCurrentRoundComplete(t, players); err != nil {
+		return err
+	}
+
+	matches, err := reverseCurrentRoundMatches(t)
+	if err != nil {
+		return err
+	}
+
+	rounds, err := t.GetRounds()
+	if err != nil {
+		return err
+	}
+
+	// Remove any existing rounds after the current round to ensure fresh pairing
+	filteredRounds := make([]model.Round, 0, len(rounds))
+	for _, r := range rounds {
+		if r.RoundNumber <= t.CurrentRound {
+			filteredRounds = append(filteredRounds, r)
+		}
+	}
+	rounds = filteredRounds
+
+	pairedAt := time.Now()
+	newRound := model.Round{
+		RoundNumber:          t.CurrentRound + 1,
+		Matches:              matches,
+		IsComplete:           false,
+		PairedAt:             &pairedAt,
+		RoundStartTime:       &pairedAt,
+		RoundDurationMinutes: t.RoundDurationMinutes,
+	}
+	rounds = append(rounds, newRound)
+
+	if err := t.SetRounds(rounds); err != nil {
+		return err
+	}
+
+	t.CurrentRound++
+	t.TotalPlayers = len(players)
+
+	if err := appendRoundStartedEvent(t, newRound); err != nil {
+		return err
+	}
+	clearRedo(t)
+
+	return nil
+}
+
+// AddPlayer adds a new player to the tournament with an auto-generated UUID.
+// Returns the generated player ID and an error if the tournament has already started.
+func AddPlayer(t *model.Tournament, name string, club string) (string, error) {
+	// Validate required fields
+	if strings.TrimSpace(name) == "" {
+		return "", fmt.Errorf("player name is required")
+	}
+
+	// Prevent adding players after tournament has started
+	if t.CurrentRound > 0 {
+		return "", fmt.Errorf("cannot add players after tournament has started (current round: %d)", t.CurrentRound)
+	}
+	if t.Status != StatusSetup {
+		return "", fmt.Errorf("cannot add players: tournament status is %s", t.Status)
+	}
+
+	// Get current players
+	players, err := t.GetPlayers()
+	if err != nil {
+		return "", err
+	}
+	if err := checkDuplicateName(t, players, name, ""); err != nil {
+		return "", err
+	}
+
+	// Generate new UUID for the player
+	playerID := uuid.NewString()
+
+	// Create new player with initialized fields
+	newPlayer := model.Player{
+		ID:           playerID,
+		Name:         name,
+		Score:        0.0,
+		OpponentIDs:  []string{},
+		Buchholz:     0.0,
+		ColorHistory: "",
+		HasBye:       false,
+		Club:         club,
+		StartRank:    nextStartRank(players),
+	}
+
+	// Add the new player
+	players = append(players, newPlayer)
+
+	// Update tournament
+	if err := t.SetPlayers(players); err != nil {
+		return "", err
+	}
+
+	// Update total players count
+	t.TotalPlayers = len(players)
+	// A round robin grows by the new player's games
+	if t.PairingSystem == PairingSystemRoundRobin {
+		t.RoundsTotal = RoundRobinRounds(t, len(players))
+	}
+
+	return playerID, nil
+}
+
+// AddLatePlayer enters a player into a Swiss tournament that has already started. With
+// awardByesForMissedRounds every round up to the current one gets a bye match worth half
+// the ByeScore for the newcomer; those count as requested byes, so they do not use up the
+// pairing bye. The player is paired from the next round on. Before round 1 it is AddPlayer.
+func AddLatePlayer(t *model.Tournament, name string, club string, awardByesForMissedRounds bool) (string, error) {
+	if t.CurrentRound == 0 {
+		return AddPlayer(t, name, club)
+	}
+	if strings.TrimSpace(name) == "" {
+		return "", fmt.Errorf("player name is required")
+	}
+	if t.PairingSystem != "" && t.PairingSystem != PairingSystemSwiss {
+		return "", fmt.Errorf("late entries are only possible in a Swiss tournament")
+	}
+	if t.Status == StatusComplete || (t.RoundsTotal > 0 && t.CurrentRound >= t.RoundsTotal) {
+		return "", fmt.Errorf("cannot join after the final round")
+	}
+
+	players, err := t.GetPlayers()
+	if err != nil {
+		return "", err
+	}
+	if err := checkDuplicateName(t, players, name, ""); err != nil {
+		return "", err
+	}
+
+	playerID := uuid.NewString()
+	players = append(players, model.Player{
+		ID:           playerID,
+		Name:         name,
+		OpponentIDs:  []string{},
+		ColorHistory: "",
+		Club:         club,
+		StartRank:    nextStartRank(players),
+	})
+
+	rounds, err := t.GetRounds()
+	if err != nil {
+		return "", err
+	}
+	missed := []int{}
+	if awardByesForMissedRounds {
+		value := byeValue(t, &model.Match{}, playerID) / 2
+		for r := range rounds {
+			round := &rounds[r]
+			if round.RoundNumber > t.CurrentRound {
+				continue
+			}
+			table := 0
+			for _, m := range round.Matches {
+				if m.TableNumber > table {
+					table = m.TableNumber
+				}
+			}
+			round.Matches = append(round.Matches, requestedByeMatches([]model.ByeRequest{{
+				PlayerID:    playerID,
+				RoundNumber: round.RoundNumber,
+				Value:       value,
+			}}, round.RoundNumber, table+1)...)
+			missed = append(missed, round.RoundNumber)
+		}
+	}
+
+	if err := t.SetPlayers(players); err != nil {
+		return "", err
+	}
+	t.TotalPlayers = len(players)
+	if err := t.SetRounds(rounds); err != nil {
+		return "", err
+	}
+	if err := RecomputePlayersFromRounds(t); err != nil {
+		return "", err
+	}
+	clearRedo(t)
+	if err := UpdateStandings(t); err != nil {
+		return "", err
+	}
+
+	events, _ := t.GetEvents()
+	detail := struct {
+		PlayerID   string `json:"player_id"`
+		Name       string `json:"name"`
+		ByeRounds  []int  `json:"bye_rounds"`
+		FirstRound int    `json:"first_round"`
+	}{
+		PlayerID:   playerID,
+		Name:       name,
+		ByeRounds:  missed,
+		FirstRound: t.CurrentRound + 1,
+	}
+	detailJSON, _ := json.Marshal(detail)
+	events = append(events, model.Event{
+		EventID:     uuid.New(),
+		Type:        "LATE_ENTRY",
+		Timestamp:   time.Now(),
+		RoundNumber: t.CurrentRound,
+		TableNumber: 0, // Not applicable for player events
+		Details:     detailJSON,
+	})
+	if err := t.SetEvents(events); err != nil {
+		return "", err
+	}
+	return playerID, nil
+}
+
+// UpdateTournamentInfo changes the title and description of a tournament.
+// A TOURNAMENT_UPDATED event is recorded with the old and new values.
+func UpdateTournamentInfo(t *model.Tournament, title string, description string) error {
+	title = strings.TrimSpace(title)
+	description = strings.TrimSpace(description)
+	if title == "" {
+		return fmt.Errorf("field must be filled: Title is required")
+	}
+	if description == "" {
+		return fmt.Errorf("field must be filled: Description is required")
+	}
+
+	oldTitle, oldDescription := t.Title, t.Description
+	t.Title = title
+	t.Description = description
+
+	// Add event log
+	events, _ := t.GetEvents()
+	detail := struct {
+		OldTitle       string `json:"old_title"`
+		NewTitle       string `json:"new_title"`
+		OldDescription string `json:"old_description"`
+		NewDescription string `json:"new_description"`
+	}{
+		OldTitle:       oldTitle,
+		NewTitle:       title,
+		OldDescription: oldDescription,
+		NewDescription: description,
+	}
+	detailJSON, _ := json.Marshal(detail)
+	events = append(events, model.Event{
+		EventID:     uuid.New(),
+		Type:        "TOURNAMENT_UPDATED",
+		Timestamp:   time.Now(),
+		RoundNumber: t.CurrentRound,
+		TableNumber: 0, // Not applicable for tournament events
+		Details:     detailJSON,
+	})
+	return t.SetEvents(events)
+}
+
+// checkDuplicateName rejects a name another player of the tournament already has (trimmed,
+// case-insensitive), unless t.AllowDuplicateNames is set. exceptID is the player being renamed.
+func checkDuplicateName(t *model.Tournament, players []model.Player, name string, exceptID string) error {
+	if t.AllowDuplicateNames {
+		return nil
+	}
+	name = strings.TrimSpace(name)
+	for _, p := range players {
+		if p.ID != exceptID && strings.EqualFold(strings.TrimSpace(p.Name), name) {
+			return fmt.Errorf("a player named %q is already in the tournament; add a club or initial to the name to tell them apart", p.Name)
+		}
+	}
+	return nil
+}
+
+// UpdatePlayer corrects the name and club of a tournament player.
+// ID, scores and history are left unchanged. A PLAYER_UPDATED event is recorded.
+func UpdatePlayer(t *model.Tournament, playerID string, name string, club string) error {
+	name = strings.TrimSpace(name)
+	club = strings.TrimSpace(club)
+	if name == "" {
+		return fmt.Errorf("player name is required")
+	}
+
+	players, err := t.GetPlayers()
+	if err != nil {
+		return err
+	}
+
+	var player *model.Player
+	for i := range players {
+		if players[i].ID == playerID {
+			player = &players[i]
+			break
+		}
+	}
+	if player == nil {
+		return fmt.Errorf("player not found: %s", playerID)
+	}
+	if err := checkDuplicateName(t, players, name, playerID); err != nil {
+		return err
+	}
+
+	oldName, oldClub := player.Name, player.Club
+	player.Name = name
+	player.Club = club
+	if err := t.SetPlayers(players); err != nil {
+		return err
+	}
+
+	// Add event log
+	events, _ := t.GetEvents()
+	detail := struct {
+		PlayerID string `json:"player_id"`
+		OldName  string `json:"old_name"`
+		NewName  string `json:"new_name"`
+		OldClub  string `json:"old_club"`
+		NewClub  string `json:"new_club"`
+	}{
+		PlayerID: playerID,
+		OldName:  oldName,
+		NewName:  name,
+		OldClub:  oldClub,
+		NewClub:  club,
+	}
+	detailJSON, _ := json.Marshal(detail)
+	events = append(events, model.Event{
+		EventID:     uuid.New(),
+		Type:        "PLAYER_UPDATED",
+		Timestamp:   time.Now(),
+		RoundNumber: t.CurrentRound,
+		TableNumber: 0, // Not applicable for player events
+		Details:     detailJSON,
+	})
+	return t.SetEvents(events)
+}
+
+// RecomputePlayersFromRounds rebuilds all player aggregates from the source of truth (rounds).
+// This prevents double-counting when results are modified or resubmitted.
+func RecomputePlayersFromRounds(t *model.Tournament) error {
+	players, err := t.GetPlayers()
+	if err != nil {
+		return err
+	}
+	rounds, err := t.GetRounds()
+	if err != nil {
+		return err
+	}
+
+	// Rescore played games from the configured points system so a mid-event change stays consistent
+	rescored := false
+	for r := range rounds {
+		for m := range rounds[r].Matches {
+			match := &rounds[r].Matches[m]
+			if scoreA, scoreB, ok := gameScores(t, match.Result); ok && (match.ScoreA != scoreA || match.ScoreB != scoreB) {
+				match.ScoreA, match.ScoreB = scoreA, scoreB
+				rescored = true
+			}
+		}
+	}
+	if rescored {
+		if err := t.SetRounds(rounds); err != nil {
+			return err
+		}
+	}
+
+	// Index players by ID for fast updates
+	index := make(map[string]*model.Player, len(players))
+	for i := range players {
+		p := &players[i]
+		// Reset aggregate fields
+		p.Score = 0
+		p.ColorHistory = ""
+		p.HasBye = false
+		p.ByeRounds = nil
+		p.OpponentIDs = []string{}
+		p.Buchholz = 0
+		p.ProgressiveScore = 0
+		p.Eliminated = false
+		if p.HeadToHeadResults == nil {
+			p.HeadToHeadResults = make(model.HeadToHeadMap)
+		} else {
+			// Clear existing head-to-head results
+			for k := range p.HeadToHeadResults {
+				delete(p.HeadToHeadResults, k)
+			}
+		}
+		index[p.ID] = p
+	}
+
+	// A withdrawn player's games after the round they withdrew in never count, for either side
+	afterWithdrawal := func(m model.Match) bool {
+		for _, id := range []string{m.PlayerA_ID, m.PlayerB_ID} {
+			if p, ok := index[id]; ok && p.Withdrawn && m.RoundNumber > p.WithdrawnInRound {
+				return true
+			}
+		}
+		return false
+	}
+
+	// Apply contributions from all matches that have a recorded result
+	// BUT ONLY from rounds <= current round
+	for _, r := range rounds {
+		// Skip rounds after current round
+		if r.RoundNumber > t.CurrentRound {
+			continue
+		}
+		
+		for _, m := range r.Matches {
+			if m.Result == "" || afterWithdrawal(m) {
+				continue
+			}
+
+			// Score updates
+			if a, ok := index[m.PlayerA_ID]; ok {
+				a.Score += m.ScoreA
+			}
+			if b, ok := index[m.PlayerB_ID]; ok {
+				b.Score += m.ScoreB
+			}
+
+			// Opponents and color history
+			if !isBye(m) {
+				// A opponent list + head-to-head + color
+				// Forfeits still count as a pairing, but no color was played
+				if a, ok := index[m.PlayerA_ID]; ok {
+					ensureOpponent(a, m.PlayerB_ID)
+					a.HeadToHeadResults[m.PlayerB_ID] += m.ScoreA
+					if !m.Forfeit {
+						if m.WhiteID == a.ID {
+							a.ColorHistory += "W"
+						} else if m.BlackID == a.ID {
+							a.ColorHistory += "B"
+						}
+					}
+				}
+				// B opponent list + head-to-head + color
+				if b, ok := index[m.PlayerB_ID]; ok {
+					ensureOpponent(b, m.PlayerA_ID)
+					b.HeadToHeadResults[m.PlayerA_ID] += m.ScoreB
+					if !m.Forfeit {
+						if m.WhiteID == b.ID {
+							b.ColorHistory += "W"
+						} else if m.BlackID == b.ID {
+							b.ColorHistory += "B"
+						}
+					}
+				}
+			} else if p, ok := index[byeRecipient(m)]; ok {
+				// BYE: mark HasBye on whichever side holds the real player
+				// (requested byes do not count as the pairing bye)
+				p.ByeRounds = append(p.ByeRounds, r.RoundNumber)
+				if !m.RequestedBye {
+					p.HasBye = true
+				}
+			}
+		}
+	}
+
+	// Knockout: the loser of every decided game is out
+	if t.PairingSystem == PairingSystemKnockout {
+		for _, r := range rounds {
+			if r.RoundNumber > t.CurrentRound {
+				continue
+			}
+			for _, m := range r.Matches {
+				if afterWithdrawal(m) {
+					continue
+				}
+				var losers []string
+				switch m.Result {
+				case model.ResultAWin, model.ResultBForfeit:
+					losers = []string{m.PlayerB_ID}
+				case model.ResultBWin, model.ResultAForfeit:
+					losers = []string{m.PlayerA_ID}
+				case model.ResultDoubleForfeit:
+					losers = []string{m.PlayerA_ID, m.PlayerB_ID}
+				}
+				for _, loser := range losers {
+					if p, ok := index[loser]; ok {
+						p.Eliminated = true
+					}
+				}
+			}
+		}
+	}
+
+	// Progressive score: sum of each player's running total after every round, in round order.
+	// Bye points count toward the running total of the round they were awarded in.
+	played := make([]model.Round, 0, len(rounds))
+	for _, r := range rounds {
+		if r.RoundNumber <= t.CurrentRound {
+			played = append(played, r)
+		}
+	}
+	sort.SliceStable(played, func(i, j int) bool {
+		return played[i].RoundNumber < played[j].RoundNumber
+	})
+	running := make(map[string]float64, len(players))
+	for _, r := range played {
+		for _, m := range r.Matches {
+			if m.Result == "" || afterWithdrawal(m) {
+				continue
+			}
+			running[m.PlayerA_ID] += m.ScoreA
+			running[m.PlayerB_ID] += m.ScoreB
+		}
+		for id, p := range index {
+			// A withdrawn player's progressive score stops at their last round
+			if p.Withdrawn && r.RoundNumber > p.WithdrawnInRound {
+				continue
+			}
+			p.ProgressiveScore += running[id]
+		}
+	}
+
+	// Persist rebuilt players
+	return t.SetPlayers(players)
+}
+
+// getPlayerName returns the player name for a given ID, or the ID if not found
+func getPlayerName(players []model.Player, playerID string) string {
+	if playerID == ByePlayerID {
+		return "BYE"
+	}
+
+	for _, p := range players {
+		if p.ID == playerID {
+			return p.Name
+		}
+	}
+
+	// Fallback to ID if name not found
+	return playerID
+}
+
+// ensureNotComplete rejects result changes once the tournament is COMPLETE; it must be reopened first.
+func ensureNotComplete(t *model.Tournament) error {
+	if t.Status == StatusComplete {
+		return fmt.Errorf("tournament is complete; reopen it to change results")
+	}
+	return nil
+}
+
+// ReopenTournament sets a COMPLETE tournament back to ACTIVE and clears its end time so that
+// results can be corrected. Completing the final round again re-completes it.
+func ReopenTournament(t *model.Tournament) error {
+	if t.Status != StatusComplete {
+		return fmt.Errorf("tournament is not complete")
+	}
+
+	events, _ := t.GetEvents()
+	detail := struct {
+		EndTime *time.Time `json:"end_time"`
+	}{
+		EndTime: t.EndTime,
+	}
+	detailJSON, _ := json.Marshal(detail)
+	events = append(events, model.Event{
+		EventID:     uuid.New(),
+		Type:        "TOURNAMENT_REOPENED",
+		Timestamp:   time.Now(),
+		RoundNumber: t.CurrentRound,
+		TableNumber: 0, // Not applicable for tournament-level events
+		Details:     detailJSON,
+	})
+	if err := t.SetEvents(events); err != nil {
+		return err
+	}
+
+	if err := SetStatus(t, StatusActive); err != nil {
+		return err
+	}
+	t.EndTime = nil
+	return nil
+}
+
+// ResetTournament wipes every round (cancelled ones included), the event log and the redo stack
+// and puts the tournament back in SETUP at round 0. The roster and the configuration are kept;
+// all player aggregates are rebuilt to zero and withdrawals are lifted. A COMPLETE tournament is
+// only reset with force. The new event log starts with TOURNAMENT_RESET.
+func ResetTournament(t *model.Tournament, force bool) error {
+	if t.Status == StatusComplete && !force {
+		return fmt.Errorf("tournament is complete: resetting it would discard the final results")
+	}
+
+	rounds, err := t.GetRounds()
+	if err != nil {
+		return err
+	}
+	events, err := t.GetEvents()
+	if err != nil {
+		return err
+	}
+	players, err := t.GetPlayers()
+	if err != nil {
+		return err
+	}
+
+	for i := range players {
+		players[i].Withdrawn = false
+		players[i].WithdrawnInRound = 0
+	}
+	if err := t.SetPlayers(players); err != nil {
+		return err
+	}
+
+	detail := struct {
+		PreviousRound  int    `json:"previous_round"`
+		PreviousStatus string `json:"previous_status"`
+		Rounds         int    `json:"rounds"`
+		Events         int    `json:"events"`
+	}{
+		PreviousRound:  t.CurrentRound,
+		PreviousStatus: t.Status,
+		Rounds:         len(rounds),
+		Events:         len(events),
+	}
+
+	if err := t.SetRounds([]model.Round{}); err != nil {
+		return err
+	}
+	t.CancelledRoundsData = nil
+	t.TiebreakMatchesData = nil
+	clearRedo(t)
+	t.CurrentRound = 0
+	t.Status = StatusSetup
+	t.EndTime = nil
+
+	if err := RecomputePlayersFromRounds(t); err != nil {
+		return err
+	}
+	if err := UpdateStandings(t); err != nil {
+		return err
+	}
+
+	detailJSON, _ := json.Marshal(detail)
+	return t.SetEvents([]model.Event{{
+		EventID:     uuid.New(),
+		Type:        "TOURNAMENT_RESET",
+		Timestamp:   time.Now(),
+		RoundNumber: 0,
+		TableNumber: 0, // Not applicable for tournament-level events
+		Details:     detailJSON,
+	}})
+}
+
+// ClearMatchResult clears the result of a specific match in a round
+func ClearMatchResult(t *model.Tournament, roundNumber int, tableNumber int) error {
+	if err := ensureNotComplete(t); err != nil {
+		return err
+	}
+	rounds, err := t.GetRounds()
+	if err != nil {
+		return err
+	}
+
+	// Find the target match and round
+	match, targetRound, err := findMatchIn(rounds, roundNumber, tableNumber)
+	if err != nil {
+		return err
+	}
+
+	// Clear the match result
+	match.Result = ""
+	match.ScoreA = 0.0
+	match.ScoreB = 0.0
+	match.Forfeit = false
+	match.ResultRecordedAt = nil
+
+	// Check if all matches in this round are now incomplete
+	allComplete := true
+	for _, m := range targetRound.Matches {
+		if m.Result == "" {
+			allComplete = false
+			break
+		}
+	}
+	targetRound.IsComplete = allComplete
+
+	// Persist updated rounds
+	if err := t.SetRounds(rounds); err != nil {
+		return err
+	}
+
+	// Recompute all players from remaining results
+	if err := RecomputePlayersFromRounds(t); err != nil {
+		return err
+	}
+	clearRedo(t)
+
+	// Recompute standings
+	UpdateStandings(t)
+
+	return nil
+}
+
+// ClearAllResultsInRound clears all results in a specific round
+func ClearAllResultsInRound(t *model.Tournament, roundNumber int) error {
+	if err := ensureNotComplete(t); err != nil {
+		return err
+	}
+	rounds, err := t.GetRounds()
+	if err != nil {
+		return err
+	}
+
+	// Find the target round
+	var targetRound *model.Round
+	for r := range rounds {
+		if rounds[r].RoundNumber == roundNumber {
+			targetRound = &rounds[r]
+			break
+		}
+	}
+	if targetRound == nil {
+		return fmt.Errorf("round %d not found", roundNumber)
+	}
+
+	// Clear all match results in this round
+	for m := range targetRound.Matches {
+		targetRound.Matches[m].Result = ""
+		targetRound.Matches[m].ScoreA = 0.0
+		targetRound.Matches[m].ScoreB = 0.0
+		targetRound.Matches[m].Forfeit = false
+		targetRound.Matches[m].ResultRecordedAt = nil
+	}
+	targetRound.IsComplete = false
+
+	// Persist updated rounds
+	if err := t.SetRounds(rounds); err != nil {
+		return err
+	}
+
+	// Recompute all players from remaining results
+	if err := RecomputePlayersFromRounds(t); err != nil {
+		return err
+	}
+	clearRedo(t)
+
+	// Recompute standings
+	UpdateStandings(t)
+
+	return nil
+}
+
+// SwapColors flips White and Black of a match that has no result yet, e.g. when the players
+// sat down on the wrong sides. PlayerA_ID and PlayerB_ID stay as paired. Bye matches and
+// matches with a recorded result are rejected. A COLORS_SWAPPED event is recorded.
+func SwapColors(t *model.Tournament, roundNumber int, tableNumber int) error {
+	rounds, err := t.GetRounds()
+	if err != nil {
+		return err
+	}
+
+	match, _, err := findMatchIn(rounds, roundNumber, tableNumber)
+	if err != nil {
+		return err
+	}
+	if isBye(*match) {
+		return fmt.Errorf("cannot swap colors of a bye at round %d, table %d", roundNumber, tableNumber)
+	}
+	if match.Result != "" {
+		return fmt.Errorf("cannot swap colors at round %d, table %d: the result is already recorded", roundNumber, tableNumber)
+	}
+
+	match.WhiteID, match.BlackID = match.BlackID, match.WhiteID
+	if err := t.SetRounds(rounds); err != nil {
+		return err
+	}
+
+	// Add event log
+	events, _ := t.GetEvents()
+	detail := struct {
+		WhiteID string `json:"white_id"`
+		BlackID string `json:"black_id"`
+	}{
+		WhiteID: match.WhiteID,
+		BlackID: match.BlackID,
+	}
+	detailJSON, _ := json.Marshal(detail)
+	events = append(events, model.Event{
+		EventID:     uuid.New(),
+		Type:        "COLORS_SWAPPED",
+		Timestamp:   time.Now(),
+		RoundNumber: roundNumber,
+		TableNumber: tableNumber,
+		Details:     detailJSON,
+	})
+	return t.SetEvents(events)
+}
+
+// RenumberTables moves the games of a round to other tables, e.g. to match the physical
+// layout of the venue, without re-pairing. mapping maps old table numbers to new ones; tables
+// left out keep their number. The resulting numbering must be a permutation of the round's
+// existing tables. Rounds with a recorded result are rejected (pre-scored requested byes
+// aside). A TABLES_RENUMBERED event is recorded.
+func RenumberTables(t *model.Tournament, roundNumber int, mapping map[int]int) error {
+	rounds, err := t.GetRounds()
+	if err != nil {
+		return err
+	}
 
-					// Build detailed error message
-					errorMsg := fmt.Sprintf("Cannot advance: Round %d is not complete (%d/%d matches finished).\n",
-						t.CurrentRound, completedMatches, totalMatches)
+	var targetRound *model.Round
+	for r := range rounds {
+		if rounds[r].RoundNumber == roundNumber {
+			targetRound = &rounds[r]
+			break
+		}
+	}
+	if targetRound == nil {
+		return fmt.Errorf("round %d not found", roundNumber)
+	}
 
-					if len(incompleteMatches) > 0 {
-						errorMsg += "Incomplete matches:\n"
-						for _, match := range incompleteMatches {
-							errorMsg += "• " + match + "\n"
-						}
-						// Remove trailing newline
-						errorMsg = strings.TrimSuffix(errorMsg, "\n")
-					}
+	existing := make(map[int]bool, len(targetRound.Matches))
+	for _, m := range targetRound.Matches {
+		if m.Result != "" && !m.RequestedBye {
+			return fmt.Errorf("cannot renumber tables of round %d: the result at table %d is already recorded", roundNumber, m.TableNumber)
+		}
+		existing[m.TableNumber] = true
+	}
+
+	// Validate the whole mapping first so a bad entry leaves the round untouched
+	used := make(map[int]int, len(targetRound.Matches))
+	for _, m := range targetRound.Matches {
+		to, ok := mapping[m.TableNumber]
+		if !ok {
+			to = m.TableNumber
+		}
+		if !existing[to] {
+			return fmt.Errorf("table %d is not a table of round %d", to, roundNumber)
+		}
+		if from, taken := used[to]; taken {
+			return fmt.Errorf("tables %d and %d cannot both move to table %d", from, m.TableNumber, to)
+		}
+		used[to] = m.TableNumber
+	}
+	for from := range mapping {
+		if !existing[from] {
+			return fmt.Errorf("match not found for round %d, table %d", roundNumber, from)
+		}
+	}
+
+	moved := make(map[int]int)
+	for m := range targetRound.Matches {
+		match := &targetRound.Matches[m]
+		if to, ok := mapping[match.TableNumber]; ok && to != match.TableNumber {
+			moved[match.TableNumber] = to
+			match.TableNumber = to
+		}
+	}
+	if len(moved) == 0 {
+		return nil
+	}
+	sort.SliceStable(targetRound.Matches, func(i, j int) bool {
+		return targetRound.Matches[i].TableNumber < targetRound.Matches[j].TableNumber
+	})
+	if err := t.SetRounds(rounds); err != nil {
+		return err
+	}
+	// Undone actions may refer to the old table numbers
+	clearRedo(t)
+
+	// Add event log
+	events, _ := t.GetEvents()
+	detail := struct {
+		Moved map[int]int `json:"moved"`
+	}{
+		Moved: moved,
+	}
+	detailJSON, _ := json.Marshal(detail)
+	events = append(events, model.Event{
+		EventID:     uuid.New(),
+		Type:        "TABLES_RENUMBERED",
+		Timestamp:   time.Now(),
+		RoundNumber: roundNumber,
+		TableNumber: 0, // Not applicable for multi-table events
+		Details:     detailJSON,
+	})
+	return t.SetEvents(events)
+}
+
+// SwapResultsInRound flips A_WIN and B_WIN for the listed tables of a round in one step.
+// Draws, byes and matches without a result are left untouched. All tables are validated
+// before anything changes, and players and standings are recomputed once at the end.
+func SwapResultsInRound(t *model.Tournament, roundNumber int, tableNumbers []int) error {
+	rounds, err := t.GetRounds()
+	if err != nil {
+		return err
+	}
+
+	// Find the target round
+	var targetRound *model.Round
+	for r := range rounds {
+		if rounds[r].RoundNumber == roundNumber {
+			targetRound = &rounds[r]
+			break
+		}
+	}
+	if targetRound == nil {
+		return fmt.Errorf("round %d not found", roundNumber)
+	}
+
+	// Resolve every table first so a bad table number leaves the round untouched
+	targets := make([]*model.Match, 0, len(tableNumbers))
+	seen := make(map[int]bool, len(tableNumbers))
+	for _, tableNumber := range tableNumbers {
+		if seen[tableNumber] {
+			continue
+		}
+		seen[tableNumber] = true
+
+		var match *model.Match
+		for m := range targetRound.Matches {
+			if targetRound.Matches[m].TableNumber == tableNumber {
+				match = &targetRound.Matches[m]
+				break
+			}
+		}
+		if match == nil {
+			return fmt.Errorf("match not found for round %d, table %d", roundNumber, tableNumber)
+		}
+		targets = append(targets, match)
+	}
+
+	// Flip decisive results only
+	swapped := make([]int, 0, len(targets))
+	for _, m := range targets {
+		switch m.Result {
+		case model.ResultAWin:
+			m.Result = model.ResultBWin
+		case model.ResultBWin:
+			m.Result = model.ResultAWin
+		case model.ResultAForfeit:
+			m.Result = model.ResultBForfeit
+		case model.ResultBForfeit:
+			m.Result = model.ResultAForfeit
+		default:
+			continue
+		}
+		m.ScoreA, m.ScoreB = m.ScoreB, m.ScoreA
+		swapped = append(swapped, m.TableNumber)
+	}
+	if len(swapped) == 0 {
+		return nil
+	}
+
+	// Persist updated rounds
+	if err := t.SetRounds(rounds); err != nil {
+		return err
+	}
+
+	// Recompute all players once for the whole batch
+	if err := RecomputePlayersFromRounds(t); err != nil {
+		return err
+	}
+
+	// Add event log
+	events, _ := t.GetEvents()
+	detail := struct {
+		Tables []int `json:"tables"`
+	}{
+		Tables: swapped,
+	}
+	detailJSON, _ := json.Marshal(detail)
+	events = append(events, model.Event{
+		EventID:     uuid.New(),
+		Type:        "RESULTS_SWAPPED",
+		Timestamp:   time.Now(),
+		RoundNumber: roundNumber,
+		TableNumber: 0, // Not applicable for multi-table events
+		Details:     detailJSON,
+	})
+	if err := t.SetEvents(events); err != nil {
+		return err
+	}
+	clearRedo(t)
+
+	// Recompute standings
+	return UpdateStandings(t)
+}
+
+// GoBackToPreviousRound allows going back to previous round while keeping all results
+func GoBackToPreviousRound(t *model.Tournament) error {
+	fmt.Printf("DEBUG: GoBackToPreviousRound called - Current round: %d\n", t.CurrentRound)
+	
+	if t.CurrentRound <= 1 {
+		fmt.Printf("DEBUG: Cannot go back - already at round 1 or no rounds exist\n")
+		return fmt.Errorf("cannot go back: already at round 1 or no rounds exist (current round: %d)", t.CurrentRound)
+	}
+
+	rounds, err := t.GetRounds()
+	if err != nil {
+		fmt.Printf("DEBUG: Error getting rounds: %v\n", err)
+		return err
+	}
+	
+	fmt.Printf("DEBUG: Found %d rounds\n", len(rounds))
+
+	// Check if previous round exists
+	previousRoundExists := false
+	for _, r := range rounds {
+		fmt.Printf("DEBUG: Checking round %d\n", r.RoundNumber)
+		if r.RoundNumber == t.CurrentRound-1 {
+			previousRoundExists = true
+			break
+		}
+	}
+
+	if !previousRoundExists {
+		fmt.Printf("DEBUG: Previous round %d not found\n", t.CurrentRound-1)
+		return fmt.Errorf("previous round %d not found", t.CurrentRound-1)
+	}
+
+	fmt.Printf("DEBUG: Going back from round %d to round %d\n", t.CurrentRound, t.CurrentRound-1)
+	
+	// Simply decrement current round - keep all rounds data intact
+	t.CurrentRound--
+
+	// Recompute all players from remaining results to ensure consistency
+	fmt.Printf("DEBUG: Recomputing players from rounds\n")
+	if err := RecomputePlayersFromRounds(t); err != nil {
+		fmt.Printf("DEBUG: Error recomputing players: %v\n", err)
+		return err
+	}
+
+	// Recompute standings
+	fmt.Printf("DEBUG: Updating standings\n")
+	UpdateStandings(t)
+
+	// Add event log
+	events, _ := t.GetEvents()
+	detail := struct {
+		PreviousRound int    `json:"previous_round"`
+		NewRound      int    `json:"new_round"`
+		Reason        string `json:"reason"`
+	}{
+		PreviousRound: t.CurrentRound + 1,
+		NewRound:      t.CurrentRound,
+		Reason:        "Went back to previous round",
+	}
+	detailJSON, _ := json.Marshal(detail)
+	events = append(events, model.Event{
+		EventID:     uuid.New(),
+		Type:        "ROUND_REVERTED",
+		Timestamp:   time.Now(),
+		RoundNumber: t.CurrentRound,
+		TableNumber: 0,
+		Details:     detailJSON,
+	})
+	if err := t.SetEvents(events); err != nil {
+		fmt.Printf("DEBUG: Error setting events: %v\n", err)
+		return err
+	}
+	clearRedo(t)
+
+	fmt.Printf("DEBUG: GoBackToPreviousRound completed successfully - New current round: %d\n", t.CurrentRound)
+	return nil
+}
+
+// CancelCurrentRound reverts the tournament to the previous round state.
+// The current round's pairings are moved to the cancelled rounds (flagged Cancelled, kept for
+// the audit trail and RestoreRound) and CurrentRound is decremented.
+// Can only be used if the current round has no recorded results.
+func CancelCurrentRound(t *model.Tournament) error {
+	if t.CurrentRound <= 0 {
+		return fmt.Errorf("cannot cancel: no rounds to cancel (current round: %d)", t.CurrentRound)
+	}
+
+	rounds, err := t.GetRounds()
+	if err != nil {
+		return err
+	}
+
+	// Find the current round
+	var currentRoundIndex = -1
+	for i, r := range rounds {
+		if r.RoundNumber == t.CurrentRound {
+			currentRoundIndex = i
+			break
+		}
+	}
+
+	if currentRoundIndex == -1 {
+		return fmt.Errorf("current round %d not found in rounds data", t.CurrentRound)
+	}
+
+	currentRound := rounds[currentRoundIndex]
+
+	// Check if current round has any recorded results
+	for _, m := range currentRound.Matches {
+		if m.Result != "" {
+			return fmt.Errorf("cannot cancel round %d: matches have recorded results. Please clear all results first", t.CurrentRound)
+		}
+	}
+
+	// Archive the current round, then remove it from rounds slice
+	cancelled, err := t.GetCancelledRounds()
+	if err != nil {
+		return err
+	}
+	currentRound.Cancelled = true
+	cancelled = append(cancelled, currentRound)
+	if err := t.SetCancelledRounds(cancelled); err != nil {
+		return err
+	}
+	rounds = append(rounds[:currentRoundIndex], rounds[currentRoundIndex+1:]...)
+
+	// Persist updated rounds
+	if err := t.SetRounds(rounds); err != nil {
+		return err
+	}
 
-					return fmt.Errorf("%s", errorMsg)
-				}
-				break
-			}
-		}
+	// Decrement current round
+	t.CurrentRound--
+
+	// Add event log for cancellation
+	events, _ := t.GetEvents()
+	detail := struct {
+		CancelledRound int    `json:"cancelled_round"`
+		Reason         string `json:"reason"`
+	}{
+		CancelledRound: currentRound.RoundNumber,
+		Reason:         "Round cancelled and reverted",
 	}
+	detailJSON, _ := json.Marshal(detail)
+	events = append(events, model.Event{
+		EventID:     uuid.New(),
+		Type:        "ROUND_CANCELLED",
+		Timestamp:   time.Now(),
+		RoundNumber: currentRound.RoundNumber,
+		TableNumber: 0, // Not applicable for round-level events
+		Details:     detailJSON,
+	})
+	if err := t.SetEvents(events); err != nil {
+		return err
+	}
+	clearRedo(t)
 
-	nextRoundNumber := t.CurrentRound + 1
+	return nil
+}
 
-	// Pass the tournament to the pairing engine for context
-	matches, err := engine.GeneratePairings(t, players, nextRoundNumber)
-	if err != nil {
+// RestoreRound brings back the most recently cancelled round with the given number as the
+// new current round, with its original pairings. It must be the round after the current one
+// and the current round must be complete; rounds stored after the current round (kept by
+// GoBackToPreviousRound) are replaced, as when advancing. The field must not have changed since
+// the round was cancelled (see ensureRosterUnchanged).
+func RestoreRound(t *model.Tournament, roundNumber int) error {
+	if err := ensureNotComplete(t); err != nil {
 		return err
 	}
+	if roundNumber != t.CurrentRound+1 {
+		return fmt.Errorf("cannot restore round %d: only round %d can follow the current round", roundNumber, t.CurrentRound+1)
+	}
 
-	// Reorder matches so the previous table-1 winner stays on table 1,
-	// BYE (if any) moves to last, and remaining matches follow standings.
-	// This prioritizes keeping table over keeping color.
-	// Helper: find previous round table-1 winner
-	prevTable1Winner := ""
-	if t.CurrentRound > 0 {
-		if rounds, rErr := t.GetRounds(); rErr == nil {
-			for _, r := range rounds {
-				if r.RoundNumber == t.CurrentRound {
-					for _, m := range r.Matches {
-						if m.TableNumber != 1 {
-							continue
-						}
-						switch m.Result {
-						case "A_WIN", "BYE_A":
-							prevTable1Winner = m.PlayerA_ID
-						case "B_WIN":
-							prevTable1Winner = m.PlayerB_ID
-						default:
-							prevTable1Winner = "" // DRAW or empty result: no anchor
-						}
-						break
-					}
-					break
-				}
-			}
-		}
+	cancelled, err := t.GetCancelledRounds()
+	if err != nil {
+		return err
 	}
-	// Build standings rank map for fallback ordering
-	rank := map[string]int{}
-	if standings, sErr := GetStandings(t); sErr == nil {
-		for i := range standings {
-			rank[standings[i].ID] = i // smaller index => higher rank
+	index := -1
+	for i := range cancelled {
+		if cancelled[i].RoundNumber == roundNumber {
+			index = i
 		}
 	}
-	// Helper: best rank involved in a match (BYE considered worst so it goes last)
-	bestRank := func(m model.Match) int {
-		if m.PlayerA_ID == ByePlayerID || m.PlayerB_ID == ByePlayerID {
-			return len(players) + 1
-		}
-		ra := rank[m.PlayerA_ID]
-		rb := rank[m.PlayerB_ID]
-		if ra < rb {
-			return ra
-		}
-		return rb
+	if index < 0 {
+		return fmt.Errorf("no cancelled round %d", roundNumber)
 	}
-	hasBye := func(m model.Match) bool {
-		return m.PlayerA_ID == ByePlayerID || m.PlayerB_ID == ByePlayerID
+
+	players, err := t.GetPlayers()
+	if err != nil {
+		return err
 	}
-	contains := func(m model.Match, id string) bool {
-		return id != "" && (m.PlayerA_ID == id || m.PlayerB_ID == id)
+	if err := ensureCurrentRoundComplete(t, players); err != nil {
+		return err
 	}
-
-	// Sort with priority:
-	// 1) match containing previous table-1 winner comes first
-	// 2) BYE matches go last
-	// 3) remaining matches ordered by standings (bestRank)
-	sort.SliceStable(matches, func(i, j int) bool {
-		iHasAnchor := contains(matches[i], prevTable1Winner)
-		jHasAnchor := contains(matches[j], prevTable1Winner)
-		if iHasAnchor != jHasAnchor {
-			return iHasAnchor
-		}
-		iBye := hasBye(matches[i])
-		jBye := hasBye(matches[j])
-		if iBye != jBye {
-			return !iBye
-		}
-		return bestRank(matches[i]) < bestRank(matches[j])
-	})
-	// Reassign table numbers after sorting
-	for i := range matches {
-		matches[i].TableNumber = i + 1
+	if err := ensureRosterUnchanged(players, cancelled[index]); err != nil {
+		return err
 	}
 
 	rounds, err := t.GetRounds()
 	if err != nil {
 		return err
 	}
-
-	// Remove any existing rounds after the current round to ensure fresh pairing
-	// This handles the case where user went back to previous round and wants to regenerate
-	filteredRounds := make([]model.Round, 0, len(rounds))
+	kept := make([]model.Round, 0, len(rounds)+1)
 	for _, r := range rounds {
 		if r.RoundNumber <= t.CurrentRound {
-			filteredRounds = append(filteredRounds, r)
+			kept = append(kept, r)
 		}
 	}
-	rounds = filteredRounds
-
-	newRound := model.Round{
-		RoundNumber: nextRoundNumber,
-		Matches:     matches,
-		IsComplete:  false,
+	restored := cancelled[index]
+	restored.Cancelled = false
+	kept = append(kept, restored)
+	if err := t.SetRounds(kept); err != nil {
+		return err
 	}
-	rounds = append(rounds, newRound)
+	cancelled = append(cancelled[:index], cancelled[index+1:]...)
+	if err := t.SetCancelledRounds(cancelled); err != nil {
+		return err
+	}
+	t.CurrentRound = roundNumber
 
-	if err := t.SetRounds(rounds); err != nil {
+	if err := RecomputePlayersFromRounds(t); err != nil {
+		return err
+	}
+	if err := UpdateStandings(t); err != nil {
 		return err
 	}
 
-	t.CurrentRound = nextRoundNumber
-	t.TotalPlayers = len(players)
+	events, _ := t.GetEvents()
+	detail := struct {
+		RestoredRound int `json:"restored_round"`
+		Matches       int `json:"matches"`
+	}{
+		RestoredRound: roundNumber,
+		Matches:       len(restored.Matches),
+	}
+	detailJSON, _ := json.Marshal(detail)
+	events = append(events, model.Event{
+		EventID:     uuid.New(),
+		Type:        "ROUND_RESTORED",
+		Timestamp:   time.Now(),
+		RoundNumber: roundNumber,
+		TableNumber: 0, // Not applicable for round-level events
+		Details:     detailJSON,
+	})
+	if err := t.SetEvents(events); err != nil {
+		return err
+	}
+	clearRedo(t)
 
 	return nil
 }
 
-// AddPlayer adds a new player to the tournament with an auto-generated UUID.
-// Returns the generated player ID and an error if the tournament has already started.
-func AddPlayer(t *model.Tournament, name string, club string) (string, error) {
-	// Validate required fields
-	if strings.TrimSpace(name) == "" {
-		return "", fmt.Errorf("player name is required")
+// ensureRosterUnchanged checks that a cancelled round still fits the field: every paired player
+// is still in the tournament and active (not withdrawn or eliminated), and every active player
+// is in the round. Otherwise the old pairings are stale and the round must be paired anew.
+func ensureRosterUnchanged(players []model.Player, round model.Round) error {
+	byID := make(map[string]model.Player, len(players))
+	for _, p := range players {
+		byID[p.ID] = p
 	}
 
-	// Prevent adding players after tournament has started
-	if t.CurrentRound > 0 {
-		return "", fmt.Errorf("cannot add players after tournament has started (current round: %d)", t.CurrentRound)
+	paired := make(map[string]bool, len(round.Matches)*2)
+	for _, m := range round.Matches {
+		for _, id := range []string{m.PlayerA_ID, m.PlayerB_ID} {
+			if id == ByePlayerID {
+				continue
+			}
+			p, ok := byID[id]
+			if !ok {
+				return fmt.Errorf("cannot restore round %d: player %s is no longer in the tournament", round.RoundNumber, id)
+			}
+			if p.Withdrawn || p.Eliminated {
+				return fmt.Errorf("cannot restore round %d: %s is no longer active", round.RoundNumber, p.Name)
+			}
+			paired[id] = true
+		}
 	}
 
-	// Get current players
-	players, err := t.GetPlayers()
-	if err != nil {
-		return "", err
+	for _, p := range players {
+		if !p.Withdrawn && !p.Eliminated && !paired[p.ID] {
+			return fmt.Errorf("cannot restore round %d: %s is not paired in it", round.RoundNumber, p.Name)
+		}
 	}
+	return nil
+}
 
-	// Generate new UUID for the player
-	playerID := uuid.NewString()
-
-	// Create new player with initialized fields
-	newPlayer := model.Player{
-		ID:           playerID,
-		Name:         name,
-		Score:        0.0,
-		OpponentIDs:  []string{},
-		Buchholz:     0.0,
-		ColorHistory: "",
-		HasBye:       false,
-		Club:         club,
+// GetFloaters returns the IDs of players who were paired outside their score group in a round.
+// Both sides of a floated match are reported: one floated down and the other floated up.
+func GetFloaters(t *model.Tournament, roundNumber int) ([]string, error) {
+	rounds, err := t.GetRounds()
+	if err != nil {
+		return nil, err
 	}
 
-	// Add the new player
-	players = append(players, newPlayer)
-
-	// Update tournament
-	if err := t.SetPlayers(players); err != nil {
-		return "", err
+	for _, r := range rounds {
+		if r.RoundNumber != roundNumber {
+			continue
+		}
+		floaters := []string{}
+		for _, m := range r.Matches {
+			if m.FloatType == "" || isBye(m) {
+				continue
+			}
+			floaters = append(floaters, m.PlayerA_ID, m.PlayerB_ID)
+		}
+		return floaters, nil
 	}
 
-	// Update total players count
-	t.TotalPlayers = len(players)
+	return nil, fmt.Errorf("round %d not found", roundNumber)
+}
 
-	return playerID, nil
+// PlayerRoundResult is one round of a player's path through the event.
+type PlayerRoundResult struct {
+	RoundNumber  int     `json:"round_number"`
+	TableNumber  int     `json:"table_number,omitempty"`
+	OpponentID   string  `json:"opponent_id,omitempty"` // "BYE" for byes, empty when the player was not paired
+	OpponentName string  `json:"opponent_name,omitempty"`
+	Color        string  `json:"color,omitempty"`  // "W" or "B"; empty for byes and forfeits
+	Result       string  `json:"result,omitempty"` // "WIN", "LOSS", "DRAW", "BYE", "FORFEIT_WIN", "FORFEIT_LOSS"; empty while unplayed
+	Points       float64 `json:"points"`
+	RunningScore float64 `json:"running_score"` // Score after this round
 }
 
-// RecomputePlayersFromRounds rebuilds all player aggregates from the source of truth (rounds).
-// This prevents double-counting when results are modified or resubmitted.
-func RecomputePlayersFromRounds(t *model.Tournament) error {
+// GetPlayerHistory returns the player's opponent, color, result and running score for every
+// round up to the current one. Rounds without a result (pending or cleared) are returned with an
+// empty Result and add nothing to the running score.
+func GetPlayerHistory(t *model.Tournament, playerID string) ([]PlayerRoundResult, error) {
 	players, err := t.GetPlayers()
 	if err != nil {
-		return err
+		return nil, err
+	}
+	found := false
+	for _, p := range players {
+		if p.ID == playerID {
+			found = true
+			break
+		}
 	}
+	if !found {
+		return nil, fmt.Errorf("player not found: %s", playerID)
+	}
+
 	rounds, err := t.GetRounds()
 	if err != nil {
-		return err
+		return nil, err
 	}
+	sort.SliceStable(rounds, func(i, j int) bool {
+		return rounds[i].RoundNumber < rounds[j].RoundNumber
+	})
 
-	// Index players by ID for fast updates
-	index := make(map[string]*model.Player, len(players))
-	for i := range players {
-		p := &players[i]
-		// Reset aggregate fields
-		p.Score = 0
-		p.ColorHistory = ""
-		p.HasBye = false
-		p.OpponentIDs = []string{}
-		p.Buchholz = 0
-		p.ProgressiveScore = 0
-		if p.HeadToHeadResults == nil {
-			p.HeadToHeadResults = make(model.HeadToHeadMap)
-		} else {
-			// Clear existing head-to-head results
-			for k := range p.HeadToHeadResults {
-				delete(p.HeadToHeadResults, k)
+	history := []PlayerRoundResult{}
+	running := 0.0
+	for _, r := range rounds {
+		if r.RoundNumber > t.CurrentRound {
+			continue
+		}
+		entry := PlayerRoundResult{RoundNumber: r.RoundNumber}
+		for _, m := range r.Matches {
+			if m.PlayerA_ID != playerID && m.PlayerB_ID != playerID {
+				continue
+			}
+			entry.TableNumber = m.TableNumber
+			entry.OpponentID = m.PlayerB_ID
+			own, other := m.ScoreA, m.ScoreB
+			if m.PlayerB_ID == playerID {
+				entry.OpponentID = m.PlayerA_ID
+				own, other = m.ScoreB, m.ScoreA
+			}
+			if entry.OpponentID == ByePlayerID {
+				entry.OpponentName = "BYE"
+			} else {
+				entry.OpponentName = getPlayerName(players, entry.OpponentID)
+			}
+			if !isBye(m) && !m.Forfeit {
+				if m.WhiteID == playerID {
+					entry.Color = "W"
+				} else if m.BlackID == playerID {
+					entry.Color = "B"
+				}
+			}
+
+			if m.Result == "" {
+				break
+			}
+			entry.Points = own
+			switch {
+			case isBye(m):
+				entry.Result = "BYE"
+			case m.Forfeit && own > other:
+				entry.Result = "FORFEIT_WIN"
+			case m.Forfeit:
+				entry.Result = "FORFEIT_LOSS"
+			case own > other:
+				entry.Result = "WIN"
+			case own < other:
+				entry.Result = "LOSS"
+			default:
+				entry.Result = "DRAW"
 			}
+			break
+		}
+		running += entry.Points
+		entry.RunningScore = running
+		history = append(history, entry)
+	}
+
+	return history, nil
+}
+
+// ValidateRound checks an already-generated round against the pairing rules and returns
+// human-readable warnings: rematches, players paired twice, a third consecutive same color
+// and second byes. It only reads the rounds, so manually edited pairings can be checked too.
+func ValidateRound(t *model.Tournament, roundNumber int) ([]string, error) {
+	rounds, err := t.GetRounds()
+	if err != nil {
+		return nil, err
+	}
+	players, err := t.GetPlayers()
+	if err != nil {
+		return nil, err
+	}
+
+	var target *model.Round
+	for i := range rounds {
+		if rounds[i].RoundNumber == roundNumber {
+			target = &rounds[i]
+			break
 		}
-		index[p.ID] = p
+	}
+	if target == nil {
+		return nil, fmt.Errorf("round %d not found", roundNumber)
 	}
 
-	// Apply contributions from all matches that have a recorded result
-	// BUT ONLY from rounds <= current round
+	// Build history from the earlier rounds, in order
+	earlier := make([]model.Round, 0, len(rounds))
 	for _, r := range rounds {
-		// Skip rounds after current round
-		if r.RoundNumber > t.CurrentRound {
-			continue
+		if r.RoundNumber < roundNumber {
+			earlier = append(earlier, r)
 		}
-		
+	}
+	sort.Slice(earlier, func(i, j int) bool {
+		return earlier[i].RoundNumber < earlier[j].RoundNumber
+	})
+
+	pairKey := func(a, b string) string {
+		if a > b {
+			a, b = b, a
+		}
+		return a + "|" + b
+	}
+	metIn := make(map[string]int)
+	colors := make(map[string]string)
+	hadBye := make(map[string]bool)
+	for _, r := range earlier {
 		for _, m := range r.Matches {
-			if m.Result == "" {
+			if isBye(m) {
+				if !m.RequestedBye {
+					hadBye[byeRecipient(m)] = true
+				}
 				continue
 			}
-
-			// Score updates
-			if a, ok := index[m.PlayerA_ID]; ok {
-				a.Score += m.ScoreA
+			metIn[pairKey(m.PlayerA_ID, m.PlayerB_ID)] = r.RoundNumber
+			if m.WhiteID != "" {
+				colors[m.WhiteID] += "W"
 			}
-			if m.PlayerB_ID != ByePlayerID {
-				if b, ok := index[m.PlayerB_ID]; ok {
-					b.Score += m.ScoreB
-				}
+			if m.BlackID != "" {
+				colors[m.BlackID] += "B"
 			}
+		}
+	}
 
-			// Opponents and color history
-			if m.PlayerB_ID != ByePlayerID {
-				// A opponent list + color
-				if a, ok := index[m.PlayerA_ID]; ok {
-					ensureOpponent(a, m.PlayerB_ID)
-					if m.WhiteID == a.ID {
-						a.ColorHistory += "W"
-					} else if m.BlackID == a.ID {
-						a.ColorHistory += "B"
-					}
-				}
-				// B opponent list + color
-				if b, ok := index[m.PlayerB_ID]; ok {
-					ensureOpponent(b, m.PlayerA_ID)
-					if m.WhiteID == b.ID {
-						b.ColorHistory += "W"
-					} else if m.BlackID == b.ID {
-						b.ColorHistory += "B"
-					}
-				}
-			} else {
-				// BYE: mark HasBye
-				if a, ok := index[m.PlayerA_ID]; ok {
-					a.HasBye = true
-				}
+	name := func(id string) string {
+		return getPlayerName(players, id)
+	}
+	thirdSameColor := func(id, color string) bool {
+		h := colors[id]
+		return len(h) >= 2 && h[len(h)-2:] == color+color
+	}
+
+	warnings := []string{}
+	seen := make(map[string]int)
+	for _, m := range target.Matches {
+		for _, id := range []string{m.PlayerA_ID, m.PlayerB_ID} {
+			if id == "" || id == ByePlayerID {
+				continue
+			}
+			if table, ok := seen[id]; ok {
+				warnings = append(warnings, fmt.Sprintf("Table %d: %s is already paired at table %d", m.TableNumber, name(id), table))
+				continue
 			}
+			seen[id] = m.TableNumber
 		}
-	}
 
-	// Persist rebuilt players
-	return t.SetPlayers(players)
-}
+		if isBye(m) {
+			if !m.RequestedBye && hadBye[byeRecipient(m)] {
+				warnings = append(warnings, fmt.Sprintf("Table %d: %s receives a second bye", m.TableNumber, name(byeRecipient(m))))
+			}
+			continue
+		}
 
-// getPlayerName returns the player name for a given ID, or the ID if not found
-func getPlayerName(players []model.Player, playerID string) string {
-	if playerID == ByePlayerID {
-		return "BYE"
-	}
+		if prev, ok := metIn[pairKey(m.PlayerA_ID, m.PlayerB_ID)]; ok {
+			// The return game of a double-round event is an intended rematch
+			if !(t.DoubleRound && roundNumber%2 == 0 && prev == roundNumber-1) {
+				warnings = append(warnings, fmt.Sprintf("Table %d: %s and %s already played each other in round %d",
+					m.TableNumber, name(m.PlayerA_ID), name(m.PlayerB_ID), prev))
+			}
+		}
 
-	for _, p := range players {
-		if p.ID == playerID {
-			return p.Name
+		if m.WhiteID != "" && thirdSameColor(m.WhiteID, "W") {
+			warnings = append(warnings, fmt.Sprintf("Table %d: %s gets White for the third time in a row", m.TableNumber, name(m.WhiteID)))
+		}
+		if m.BlackID != "" && thirdSameColor(m.BlackID, "B") {
+			warnings = append(warnings, fmt.Sprintf("Table %d: %s gets Black for the third time in a row", m.TableNumber, name(m.BlackID)))
 		}
 	}
 
-	// Fallback to ID if name not found
-	return playerID
+	return warnings, nil
 }
 
-// ClearMatchResult clears the result of a specific match in a round
-func ClearMatchResult(t *model.Tournament, roundNumber int, tableNumber int) error {
+// GetRoundRemainingSeconds returns the clock time left in a round, counted from its
+// RoundStartTime. Rounds without a time control, completed rounds and rounds past
+// their time report 0.
+func GetRoundRemainingSeconds(t *model.Tournament, roundNumber int) (int, error) {
 	rounds, err := t.GetRounds()
 	if err != nil {
-		return err
+		return 0, err
 	}
 
-	// Find the target match and round
-	var match *model.Match
-	var targetRound *model.Round
-	for r := range rounds {
-		if rounds[r].RoundNumber != roundNumber {
+	for _, r := range rounds {
+		if r.RoundNumber != roundNumber {
 			continue
 		}
-		targetRound = &rounds[r]
-		for m := range rounds[r].Matches {
-			if rounds[r].Matches[m].TableNumber == tableNumber {
-				match = &rounds[r].Matches[m]
-				break
-			}
+		if r.IsComplete || r.RoundStartTime == nil || r.RoundDurationMinutes <= 0 {
+			return 0, nil
 		}
-		if match != nil {
-			break
+		deadline := r.RoundStartTime.Add(time.Duration(r.RoundDurationMinutes) * time.Minute)
+		remaining := int(time.Until(deadline).Seconds())
+		if remaining < 0 {
+			remaining = 0
 		}
-	}
-	if match == nil {
-		return fmt.Errorf("match not found for round %d, table %d", roundNumber, tableNumber)
+		return remaining, nil
 	}
 
-	// Clear the match result
-	match.Result = ""
-	match.ScoreA = 0.0
-	match.ScoreB = 0.0
+	return 0, fmt.Errorf("round %d not found", roundNumber)
+}
 
-	// Check if all matches in this round are now incomplete
-	allComplete := true
-	for _, m := range targetRound.Matches {
-		if m.Result == "" {
-			allComplete = false
-			break
-		}
-	}
-	targetRound.IsComplete = allComplete
+// RoundProgress summarizes how many results of a round are in.
+type RoundProgress struct {
+	RoundNumber  int        `json:"round_number"`
+	TotalMatches int        `json:"total_matches"`
+	Completed    int        `json:"completed"`
+	LastResultAt *time.Time `json:"last_result_at,omitempty"` // Most recent ResultRecordedAt (nil if none)
+}
 
-	// Persist updated rounds
-	if err := t.SetRounds(rounds); err != nil {
-		return err
+// GetRoundProgress counts the completed matches of a round and finds when the latest result came in.
+func GetRoundProgress(t *model.Tournament, roundNumber int) (RoundProgress, error) {
+	rounds, err := t.GetRounds()
+	if err != nil {
+		return RoundProgress{}, err
 	}
 
-	// Recompute all players from remaining results
-	if err := RecomputePlayersFromRounds(t); err != nil {
-		return err
+	for _, r := range rounds {
+		if r.RoundNumber != roundNumber {
+			continue
+		}
+		progress := RoundProgress{RoundNumber: roundNumber, TotalMatches: len(r.Matches)}
+		for _, m := range r.Matches {
+			if m.Result == "" {
+				continue
+			}
+			progress.Completed++
+			if m.ResultRecordedAt != nil && (progress.LastResultAt == nil || m.ResultRecordedAt.After(*progress.LastResultAt)) {
+				progress.LastResultAt = m.ResultRecordedAt
+			}
+		}
+		return progress, nil
 	}
 
-	// Recompute standings
-	UpdateStandings(t)
-
-	return nil
+	return RoundProgress{}, fmt.Errorf("round %d not found", roundNumber)
 }
 
-// ClearAllResultsInRound clears all results in a specific round
-func ClearAllResultsInRound(t *model.Tournament, roundNumber int) error {
+// GetPendingMatches returns the matches of a round still waiting for a result, by table
+// number, with player names resolved.
+func GetPendingMatches(t *model.Tournament, roundNumber int) ([]MatchDetail, error) {
 	rounds, err := t.GetRounds()
 	if err != nil {
-		return err
+		return nil, err
+	}
+	players, err := t.GetPlayers()
+	if err != nil {
+		return nil, err
 	}
 
-	// Find the target round
-	var targetRound *model.Round
-	for r := range rounds {
-		if rounds[r].RoundNumber == roundNumber {
-			targetRound = &rounds[r]
-			break
+	name := func(id string) string {
+		if id == "" {
+			return ""
 		}
+		return getPlayerName(players, id)
 	}
-	if targetRound == nil {
-		return fmt.Errorf("round %d not found", roundNumber)
+	for _, r := range rounds {
+		if r.RoundNumber != roundNumber {
+			continue
+		}
+		pending := []MatchDetail{}
+		for _, m := range r.Matches {
+			if m.Result != "" {
+				continue
+			}
+			pending = append(pending, MatchDetail{
+				Match:       m,
+				PlayerAName: name(m.PlayerA_ID),
+				PlayerBName: name(m.PlayerB_ID),
+				WhiteName:   name(m.WhiteID),
+				BlackName:   name(m.BlackID),
+			})
+		}
+		sort.SliceStable(pending, func(i, j int) bool {
+			return pending[i].TableNumber < pending[j].TableNumber
+		})
+		return pending, nil
 	}
 
-	// Clear all match results in this round
-	for m := range targetRound.Matches {
-		targetRound.Matches[m].Result = ""
-		targetRound.Matches[m].ScoreA = 0.0
-		targetRound.Matches[m].ScoreB = 0.0
-	}
-	targetRound.IsComplete = false
+	return nil, fmt.Errorf("round %d not found", roundNumber)
+}
 
-	// Persist updated rounds
-	if err := t.SetRounds(rounds); err != nil {
-		return err
+// GetOpponentFor finds a player's match in a round and returns the opponent, the player's own
+// color ("W" or "B") and the table. For a bye the opponent is ByePlayerID and the color empty.
+func GetOpponentFor(t *model.Tournament, roundNumber int, playerID string) (string, string, int, error) {
+	rounds, err := t.GetRounds()
+	if err != nil {
+		return "", "", 0, err
 	}
 
-	// Recompute all players from remaining results
-	if err := RecomputePlayersFromRounds(t); err != nil {
-		return err
+	for _, r := range rounds {
+		if r.RoundNumber != roundNumber {
+			continue
+		}
+		for _, m := range r.Matches {
+			var opponentID string
+			switch playerID {
+			case m.PlayerA_ID:
+				opponentID = m.PlayerB_ID
+			case m.PlayerB_ID:
+				opponentID = m.PlayerA_ID
+			default:
+				continue
+			}
+			if isBye(m) {
+				return ByePlayerID, "", m.TableNumber, nil
+			}
+			color := "B"
+			if m.WhiteID == playerID {
+				color = "W"
+			}
+			return opponentID, color, m.TableNumber, nil
+		}
+		return "", "", 0, fmt.Errorf("player %s is not paired in round %d", playerID, roundNumber)
 	}
 
-	// Recompute standings
-	UpdateStandings(t)
+	return "", "", 0, fmt.Errorf("round %d not found", roundNumber)
+}
 
-	return nil
+// StandingsEntry is one line of a standings snapshot stored in a ROUND_FINALIZED event.
+type StandingsEntry struct {
+	Rank     int     `json:"rank"`
+	PlayerID string  `json:"player_id"`
+	Name     string  `json:"name"`
+	Score    float64 `json:"score"`
+	Buchholz float64 `json:"buchholz"`
 }
 
-// GoBackToPreviousRound allows going back to previous round while keeping all results
-func GoBackToPreviousRound(t *model.Tournament) error {
-	fmt.Printf("DEBUG: GoBackToPreviousRound called - Current round: %d\n", t.CurrentRound)
-	
-	if t.CurrentRound <= 1 {
-		fmt.Printf("DEBUG: Cannot go back - already at round 1 or no rounds exist\n")
-		return fmt.Errorf("cannot go back: already at round 1 or no rounds exist (current round: %d)", t.CurrentRound)
+// FinalizeRound is the director's explicit checkpoint for a round: it checks that every
+// match has a result, marks the round complete and logs ROUND_FINALIZED with a snapshot of
+// the standings after that round. Finalizing again without any result recorded since the
+// last ROUND_FINALIZED of the round changes nothing.
+func FinalizeRound(t *model.Tournament, roundNumber int) error {
+	if roundNumber < 1 || roundNumber > t.CurrentRound {
+		return fmt.Errorf("round %d has not been played", roundNumber)
 	}
 
 	rounds, err := t.GetRounds()
 	if err != nil {
-		fmt.Printf("DEBUG: Error getting rounds: %v\n", err)
 		return err
 	}
-	
-	fmt.Printf("DEBUG: Found %d rounds\n", len(rounds))
-
-	// Check if previous round exists
-	previousRoundExists := false
-	for _, r := range rounds {
-		fmt.Printf("DEBUG: Checking round %d\n", r.RoundNumber)
-		if r.RoundNumber == t.CurrentRound-1 {
-			previousRoundExists = true
+	var round *model.Round
+	for i := range rounds {
+		if rounds[i].RoundNumber == roundNumber {
+			round = &rounds[i]
 			break
 		}
 	}
+	if round == nil {
+		return fmt.Errorf("round %d not found", roundNumber)
+	}
 
-	if !previousRoundExists {
-		fmt.Printf("DEBUG: Previous round %d not found\n", t.CurrentRound-1)
-		return fmt.Errorf("previous round %d not found", t.CurrentRound-1)
+	missing := []string{}
+	var lastResultAt *time.Time
+	for _, m := range round.Matches {
+		if m.Result == "" {
+			missing = append(missing, strconv.Itoa(m.TableNumber))
+			continue
+		}
+		if m.ResultRecordedAt != nil && (lastResultAt == nil || m.ResultRecordedAt.After(*lastResultAt)) {
+			lastResultAt = m.ResultRecordedAt
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("cannot finalize round %d: no result yet on table(s) %s", roundNumber, strings.Join(missing, ", "))
 	}
 
-	fmt.Printf("DEBUG: Going back from round %d to round %d\n", t.CurrentRound, t.CurrentRound-1)
-	
-	// Simply decrement current round - keep all rounds data intact
-	t.CurrentRound--
+	// Already finalized and nothing recorded since
+	finalized, _ := filterEvents(t, func(e model.Event) bool {
+		return e.Type == "ROUND_FINALIZED" && e.RoundNumber == roundNumber
+	})
+	if len(finalized) > 0 && round.IsComplete &&
+		(lastResultAt == nil || !lastResultAt.After(finalized[len(finalized)-1].Timestamp)) {
+		return nil
+	}
 
-	// Recompute all players from remaining results to ensure consistency
-	fmt.Printf("DEBUG: Recomputing players from rounds\n")
-	if err := RecomputePlayersFromRounds(t); err != nil {
-		fmt.Printf("DEBUG: Error recomputing players: %v\n", err)
-		return err
+	if !round.IsComplete {
+		round.IsComplete = true
+		if err := t.SetRounds(rounds); err != nil {
+			return err
+		}
 	}
 
-	// Recompute standings
-	fmt.Printf("DEBUG: Updating standings\n")
-	UpdateStandings(t)
+	// The snapshot is the standings after this round, even when later rounds were played since;
+	// they are computed on a copy, as in GetStandingsWithMovement
+	asOf := *t
+	if roundNumber < t.CurrentRound {
+		asOf.CurrentRound = roundNumber
+		if err := RecomputePlayersFromRounds(&asOf); err != nil {
+			return err
+		}
+	}
+	standings, err := GetStandings(&asOf)
+	if err != nil {
+		return err
+	}
+	snapshot := make([]StandingsEntry, 0, len(standings))
+	for i, p := range standings {
+		snapshot = append(snapshot, StandingsEntry{
+			Rank:     i + 1,
+			PlayerID: p.ID,
+			Name:     p.Name,
+			Score:    p.Score,
+			Buchholz: p.Buchholz,
+		})
+	}
 
-	// Add event log
 	events, _ := t.GetEvents()
 	detail := struct {
-		PreviousRound int    `json:"previous_round"`
-		NewRound      int    `json:"new_round"`
-		Reason        string `json:"reason"`
+		Standings []StandingsEntry `json:"standings"`
 	}{
-		PreviousRound: t.CurrentRound + 1,
-		NewRound:      t.CurrentRound,
-		Reason:        "Went back to previous round",
+		Standings: snapshot,
 	}
 	detailJSON, _ := json.Marshal(detail)
 	events = append(events, model.Event{
 		EventID:     uuid.New(),
-		Type:        "ROUND_REVERTED",
+		Type:        "ROUND_FINALIZED",
 		Timestamp:   time.Now(),
-		RoundNumber: t.CurrentRound,
-		TableNumber: 0,
+		RoundNumber: roundNumber,
+		TableNumber: 0, // Not applicable for round-level events
 		Details:     detailJSON,
 	})
-	if err := t.SetEvents(events); err != nil {
-		fmt.Printf("DEBUG: Error setting events: %v\n", err)
-		return err
+	return t.SetEvents(events)
+}
+
+// SetRoundDuration sets the default time allowed per round and applies it to the
+// current round when that round is still being played.
+func SetRoundDuration(t *model.Tournament, minutes int) error {
+	if minutes < 0 {
+		return fmt.Errorf("invalid round duration %d", minutes)
 	}
+	t.RoundDurationMinutes = minutes
 
-	fmt.Printf("DEBUG: GoBackToPreviousRound completed successfully - New current round: %d\n", t.CurrentRound)
+	rounds, err := t.GetRounds()
+	if err != nil {
+		return err
+	}
+	for i := range rounds {
+		if rounds[i].RoundNumber == t.CurrentRound && !rounds[i].IsComplete {
+			rounds[i].RoundDurationMinutes = minutes
+			return t.SetRounds(rounds)
+		}
+	}
 	return nil
 }
 
-// CancelCurrentRound reverts the tournament to the previous round state.
-// This removes the current round's pairings and decrements CurrentRound.
-// Can only be used if the current round has no recorded results.
-func CancelCurrentRound(t *model.Tournament) error {
-	if t.CurrentRound <= 0 {
-		return fmt.Errorf("cannot cancel: no rounds to cancel (current round: %d)", t.CurrentRound)
+// RoundTiming describes how long a single round took.
+type RoundTiming struct {
+	RoundNumber     int        `json:"round_number"`
+	PairedAt        *time.Time `json:"paired_at,omitempty"`
+	CompletedAt     *time.Time `json:"completed_at,omitempty"`
+	DurationSeconds int64      `json:"duration_seconds"` // Pairing to completion, or to now while the round is running
+}
+
+// TournamentTimings summarizes the elapsed time of the event and each of its rounds.
+type TournamentTimings struct {
+	StartTime      time.Time     `json:"start_time"`
+	EndTime        *time.Time    `json:"end_time,omitempty"`
+	ElapsedSeconds int64         `json:"elapsed_seconds"` // StartTime to EndTime, or to now while the event is running
+	Rounds         []RoundTiming `json:"rounds"`
+}
+
+// GetTimings returns the total elapsed time of the tournament and per-round durations.
+// Round durations run from the round's PairedAt to its latest ROUND_COMPLETED event.
+func GetTimings(t *model.Tournament) (TournamentTimings, error) {
+	now := time.Now()
+	timings := TournamentTimings{
+		StartTime: t.StartTime,
+		EndTime:   t.EndTime,
+		Rounds:    []RoundTiming{},
+	}
+	end := now
+	if t.EndTime != nil {
+		end = *t.EndTime
+	}
+	if !t.StartTime.IsZero() {
+		timings.ElapsedSeconds = int64(end.Sub(t.StartTime).Seconds())
 	}
 
 	rounds, err := t.GetRounds()
 	if err != nil {
-		return err
+		return timings, err
+	}
+	events, err := t.GetEvents()
+	if err != nil {
+		return timings, err
 	}
 
-	// Find the current round
-	var currentRoundIndex = -1
-	for i, r := range rounds {
-		if r.RoundNumber == t.CurrentRound {
-			currentRoundIndex = i
-			break
+	// Latest completion timestamp per round
+	completedAt := make(map[int]time.Time)
+	for _, e := range events {
+		if e.Type != "ROUND_COMPLETED" {
+			continue
+		}
+		if prev, ok := completedAt[e.RoundNumber]; !ok || e.Timestamp.After(prev) {
+			completedAt[e.RoundNumber] = e.Timestamp
 		}
 	}
 
-	if currentRoundIndex == -1 {
-		return fmt.Errorf("current round %d not found in rounds data", t.CurrentRound)
+	sort.SliceStable(rounds, func(i, j int) bool {
+		return rounds[i].RoundNumber < rounds[j].RoundNumber
+	})
+	for _, r := range rounds {
+		rt := RoundTiming{
+			RoundNumber: r.RoundNumber,
+			PairedAt:    r.PairedAt,
+		}
+		// A round that was reopened by clearing a result is still running
+		if ts, ok := completedAt[r.RoundNumber]; ok && r.IsComplete {
+			rt.CompletedAt = &ts
+		}
+		if r.PairedAt != nil {
+			roundEnd := now
+			if rt.CompletedAt != nil {
+				roundEnd = *rt.CompletedAt
+			}
+			rt.DurationSeconds = int64(roundEnd.Sub(*r.PairedAt).Seconds())
+		}
+		timings.Rounds = append(timings.Rounds, rt)
 	}
 
-	currentRound := rounds[currentRoundIndex]
+	return timings, nil
+}
 
-	// Check if current round has any recorded results
-	for _, m := range currentRound.Matches {
-		if m.Result != "" {
-			return fmt.Errorf("cannot cancel round %d: matches have recorded results. Please clear all results first", t.CurrentRound)
-		}
-	}
+// TournamentStats are aggregate numbers for a dashboard.
+type TournamentStats struct {
+	GamesPlayed        int     `json:"games_played"` // Games decided over the board (no byes, no forfeits)
+	DecisiveGames      int     `json:"decisive_games"`
+	Draws              int     `json:"draws"`
+	DecisivePercentage float64 `json:"decisive_percentage"` // Of GamesPlayed
+	DrawPercentage     float64 `json:"draw_percentage"`     // Of GamesPlayed
+	Forfeits           int     `json:"forfeits"`
+	ByesGiven          int     `json:"byes_given"` // Pairing and requested byes
+	AverageScore       float64 `json:"average_score"`
+	HighestScore       float64 `json:"highest_score"`
+	LowestScore        float64 `json:"lowest_score"`
+	RoundsCompleted    int     `json:"rounds_completed"`
+}
 
-	// Remove the current round from rounds slice
-	rounds = append(rounds[:currentRoundIndex], rounds[currentRoundIndex+1:]...)
+// GetTournamentStats summarizes the rounds up to CurrentRound (tiebreak games excluded) and the
+// players' scores. All zeros for a tournament that has not started.
+func GetTournamentStats(t *model.Tournament) (TournamentStats, error) {
+	stats := TournamentStats{}
 
-	// Persist updated rounds
-	if err := t.SetRounds(rounds); err != nil {
-		return err
+	rounds, err := t.GetRounds()
+	if err != nil {
+		return stats, err
+	}
+	for _, r := range rounds {
+		if r.RoundNumber > t.CurrentRound {
+			continue
+		}
+		if r.IsComplete {
+			stats.RoundsCompleted++
+		}
+		for _, m := range r.Matches {
+			switch {
+			case isBye(m):
+				stats.ByesGiven++
+			case m.Result == model.ResultAWin, m.Result == model.ResultBWin:
+				stats.GamesPlayed++
+				stats.DecisiveGames++
+			case m.Result == model.ResultDraw:
+				stats.GamesPlayed++
+				stats.Draws++
+			case m.Forfeit:
+				stats.Forfeits++
+			}
+		}
+	}
+	if stats.GamesPlayed > 0 {
+		stats.DecisivePercentage = float64(stats.DecisiveGames) / float64(stats.GamesPlayed) * 100
+		stats.DrawPercentage = float64(stats.Draws) / float64(stats.GamesPlayed) * 100
 	}
 
-	// Decrement current round
-	t.CurrentRound--
-
-	// Add event log for cancellation
-	events, _ := t.GetEvents()
-	detail := struct {
-		CancelledRound int    `json:"cancelled_round"`
-		Reason         string `json:"reason"`
-	}{
-		CancelledRound: currentRound.RoundNumber,
-		Reason:         "Round cancelled and reverted",
+	players, err := t.GetPlayers()
+	if err != nil {
+		return stats, err
 	}
-	detailJSON, _ := json.Marshal(detail)
-	events = append(events, model.Event{
-		EventID:     uuid.New(),
-		Type:        "ROUND_CANCELLED",
-		Timestamp:   time.Now(),
-		RoundNumber: currentRound.RoundNumber,
-		TableNumber: 0, // Not applicable for round-level events
-		Details:     detailJSON,
-	})
-	if err := t.SetEvents(events); err != nil {
-		return err
+	total := 0.0
+	for i, p := range players {
+		total += p.Score
+		if i == 0 || p.Score > stats.HighestScore {
+			stats.HighestScore = p.Score
+		}
+		if i == 0 || p.Score < stats.LowestScore {
+			stats.LowestScore = p.Score
+		}
+	}
+	if len(players) > 0 {
+		stats.AverageScore = total / float64(len(players))
 	}
 
-	return nil
+	return stats, nil
+}
+
+// PairingsPDFOptions adjusts the round pairings PDF. The zero value is the default layout.
+type PairingsPDFOptions struct {
+	IncludeColorHistory bool `json:"include_color_history"` // Add a Colors column (e.g. "WBW") next to each player
+	IncludeResultBoxes  bool `json:"include_result_boxes"`  // Add a Result column with a blank line to write each result by hand
 }
 
 // ExportRoundPairingsToPDF generates a PDF file with tournament round pairings
 // Returns the PDF bytes and any error encountered
 func ExportRoundPairingsToPDF(t *model.Tournament, roundNumber int) ([]byte, error) {
+	return ExportRoundPairingsToPDFWithOptions(t, roundNumber, PairingsPDFOptions{})
+}
+
+// ExportScoreSheetPDF generates the round pairings with a blank Result box per game for the
+// arbiter to fill in by hand; byes show their automatic result.
+func ExportScoreSheetPDF(t *model.Tournament, roundNumber int) ([]byte, error) {
+	return ExportRoundPairingsToPDFWithOptions(t, roundNumber, PairingsPDFOptions{IncludeResultBoxes: true})
+}
+
+// ExportRoundPairingsToPDFWithOptions generates the round pairings PDF with the given options.
+func ExportRoundPairingsToPDFWithOptions(t *model.Tournament, roundNumber int, opts PairingsPDFOptions) ([]byte, error) {
 	// Get tournament data
 	players, err := t.GetPlayers()
 	if err != nil {
@@ -1327,50 +4653,45 @@ func ExportRoundPairingsToPDF(t *model.Tournament, roundNumber int) ([]byte, err
 	)
 
 	// Add table headers
-	m.AddRows(
-		row.New(12).Add(
-			col.New(2).Add(
-				text.New("Table", props.Text{
-					Top:   2,
-					Style: fontstyle.Bold,
-					Align: align.Center,
-					Size:  10,
-				}),
-			),
-			col.New(3).Add(
-				text.New("White Player", props.Text{
-					Top:   2,
-					Style: fontstyle.Bold,
-					Align: align.Center,
-					Size:  10,
-				}),
-			),
-			col.New(2).Add(
-				text.New("White Points", props.Text{
-					Top:   2,
-					Style: fontstyle.Bold,
-					Align: align.Center,
-					Size:  10,
-				}),
-			),
-			col.New(3).Add(
-				text.New("Black Player", props.Text{
-					Top:   2,
-					Style: fontstyle.Bold,
-					Align: align.Center,
-					Size:  10,
-				}),
-			),
-			col.New(2).Add(
-				text.New("Black Points", props.Text{
-					Top:   2,
-					Style: fontstyle.Bold,
-					Align: align.Center,
-					Size:  10,
-				}),
-			),
-		),
+	headerProps := props.Text{
+		Top:   2,
+		Style: fontstyle.Bold,
+		Align: align.Center,
+		Size:  10,
+	}
+	// With color history each name gets a narrow Colors column, taken from the points columns
+	tableWidth, pointsWidth := 2, 2
+	if opts.IncludeColorHistory {
+		pointsWidth = 1
+	}
+	// The Result column takes what the narrower Table and points columns leave over
+	resultWidth := 0
+	if opts.IncludeResultBoxes {
+		tableWidth, pointsWidth = 1, 1
+		resultWidth = 12 - tableWidth - 6 - 2*pointsWidth
+		if opts.IncludeColorHistory {
+			resultWidth -= 2
+		}
+	}
+	headerCols := []core.Col{
+		col.New(tableWidth).Add(text.New("Table", headerProps)),
+		col.New(3).Add(text.New("White Player", headerProps)),
+	}
+	if opts.IncludeColorHistory {
+		headerCols = append(headerCols, col.New(1).Add(text.New("Colors", headerProps)))
+	}
+	headerCols = append(headerCols,
+		col.New(pointsWidth).Add(text.New("White Points", headerProps)),
+		col.New(3).Add(text.New("Black Player", headerProps)),
 	)
+	if opts.IncludeColorHistory {
+		headerCols = append(headerCols, col.New(1).Add(text.New("Colors", headerProps)))
+	}
+	headerCols = append(headerCols, col.New(pointsWidth).Add(text.New("Black Points", headerProps)))
+	if opts.IncludeResultBoxes {
+		headerCols = append(headerCols, col.New(resultWidth).Add(text.New("Result", headerProps)))
+	}
+	m.AddRows(row.New(12).Add(headerCols...))
 
 	// Sort matches by table number
 	matches := make([]model.Match, len(targetRound.Matches))
@@ -1379,71 +4700,50 @@ func ExportRoundPairingsToPDF(t *model.Tournament, roundNumber int) ([]byte, err
 		return matches[i].TableNumber < matches[j].TableNumber
 	})
 
+	cellProps := props.Text{
+		Top:   1,
+		Align: align.Center,
+		Size:  9,
+	}
+
 	// Add match data rows
 	for _, match := range matches {
-		whitePlayer := getPlayerName(players, match.WhiteID)
-		blackPlayer := getPlayerName(players, match.BlackID)
+		// Names and current points; BYE is printed opposite the real player on either side
+		whitePlayer, whitePoints, blackPlayer, blackPoints := pairingRowSides(players, playerMap, match)
 
-		// Handle BYE matches
-		if match.PlayerB_ID == ByePlayerID {
-			blackPlayer = "BYE"
+		cols := []core.Col{
+			col.New(tableWidth).Add(text.New(fmt.Sprintf("%d", match.TableNumber), cellProps)),
+			col.New(3).Add(text.New(whitePlayer, cellProps)),
 		}
-
-		// Get current points for players
-		whitePoints := "0.0"
-		blackPoints := "0.0"
-
-		if p, exists := playerMap[match.WhiteID]; exists {
-			whitePoints = fmt.Sprintf("%.1f", p.Score)
+		if opts.IncludeColorHistory {
+			cols = append(cols, col.New(1).Add(text.New(playerMap[match.WhiteID].ColorHistory, cellProps)))
 		}
-
-		if match.BlackID != "" && match.PlayerB_ID != ByePlayerID {
-			if p, exists := playerMap[match.BlackID]; exists {
-				blackPoints = fmt.Sprintf("%.1f", p.Score)
+		cols = append(cols,
+			col.New(pointsWidth).Add(text.New(whitePoints, cellProps)),
+			col.New(3).Add(text.New(blackPlayer, cellProps)),
+		)
+		if opts.IncludeColorHistory {
+			cols = append(cols, col.New(1).Add(text.New(playerMap[match.BlackID].ColorHistory, cellProps)))
+		}
+		cols = append(cols, col.New(pointsWidth).Add(text.New(blackPoints, cellProps)))
+		rowHeight := 8.0
+		if opts.IncludeResultBoxes {
+			// Byes are scored automatically; games get a line to write on, with room for a pen
+			result := "__________"
+			if isBye(match) {
+				// Same side as pairingRowSides puts the recipient on
+				recipient := byeRecipient(match)
+				value := byeValue(t, &match, recipient)
+				if match.BlackID == recipient {
+					result = fmt.Sprintf("0 - %.1f", value)
+				} else {
+					result = fmt.Sprintf("%.1f - 0", value)
+				}
 			}
-		} else if match.PlayerB_ID == ByePlayerID {
-			blackPoints = "-"
+			cols = append(cols, col.New(resultWidth).Add(text.New(result, cellProps)))
+			rowHeight = 10
 		}
-
-		m.AddRows(
-			row.New(8).Add(
-				col.New(2).Add(
-					text.New(fmt.Sprintf("%d", match.TableNumber), props.Text{
-						Top:   1,
-						Align: align.Center,
-						Size:  9,
-					}),
-				),
-				col.New(3).Add(
-					text.New(whitePlayer, props.Text{
-						Top:   1,
-						Align: align.Center,
-						Size:  9,
-					}),
-				),
-				col.New(2).Add(
-					text.New(whitePoints, props.Text{
-						Top:   1,
-						Align: align.Center,
-						Size:  9,
-					}),
-				),
-				col.New(3).Add(
-					text.New(blackPlayer, props.Text{
-						Top:   1,
-						Align: align.Center,
-						Size:  9,
-					}),
-				),
-				col.New(2).Add(
-					text.New(blackPoints, props.Text{
-						Top:   1,
-						Align: align.Center,
-						Size:  9,
-					}),
-				),
-			),
-		)
+		m.AddRows(row.New(rowHeight).Add(cols...))
 	}
 
 	// Add footer with timestamp and maintenance info
@@ -1541,131 +4841,126 @@ func ExportStandingsToPDF(t *model.Tournament) ([]byte, error) {
 		)
 	}
 
-	// Add standings title
+	// Add tournament ID and current round
 	m.AddRows(
-		row.New(15).Add(
+		row.New(6).Add(
 			col.New(12).Add(
-				text.New("Klasemen Turnamen", props.Text{
-					Top:   3,
-					Style: fontstyle.Bold,
+				text.New(fmt.Sprintf("ID: %s  |  Round %d", t.ID.String(), t.CurrentRound), props.Text{
+					Top:   1,
 					Align: align.Center,
-					Size:  14,
+					Size:  9,
 				}),
 			),
 		),
 	)
 
-	// Add table headers
+	// Add standings title
 	m.AddRows(
-		row.New(12).Add(
-			col.New(1).Add(
-				text.New("Rank", props.Text{
-					Top:   2,
-					Style: fontstyle.Bold,
-					Align: align.Center,
-					Size:  9,
-				}),
-			),
-			col.New(3).Add(
-				text.New("Nama", props.Text{
-					Top:   2,
-					Style: fontstyle.Bold,
-					Align: align.Center,
-					Size:  9,
-				}),
-			),
-			col.New(1).Add(
-				text.New("Poin", props.Text{
-					Top:   2,
-					Style: fontstyle.Bold,
-					Align: align.Center,
-					Size:  9,
-				}),
-			),
-			col.New(2).Add(
-				text.New("Buchholz", props.Text{
-					Top:   2,
-					Style: fontstyle.Bold,
-					Align: align.Center,
-					Size:  9,
-				}),
-			),
-			col.New(2).Add(
-				text.New("Progressive", props.Text{
-					Top:   2,
-					Style: fontstyle.Bold,
-					Align: align.Center,
-					Size:  9,
-				}),
-			),
-			col.New(3).Add(
-				text.New("Club / Domisili", props.Text{
-					Top:   2,
+		row.New(15).Add(
+			col.New(12).Add(
+				text.New("Klasemen Turnamen", props.Text{
+					Top:   3,
 					Style: fontstyle.Bold,
 					Align: align.Center,
-					Size:  9,
+					Size:  14,
 				}),
 			),
 		),
 	)
 
+	// Tie-break columns follow the configured order; the grid has room for five
+	tieBreaks := printableTieBreaks(t)
+	if len(tieBreaks) > 5 {
+		tieBreaks = tieBreaks[:5]
+	}
+	tieBreakWidth := 1
+	if len(tieBreaks) <= 2 {
+		tieBreakWidth = 2
+	}
+	clubWidth := 12 - 1 - 3 - 1 - tieBreakWidth*len(tieBreaks)
+
+	// Add table headers
+	headerProps := props.Text{
+		Top:   2,
+		Style: fontstyle.Bold,
+		Align: align.Center,
+		Size:  9,
+	}
+	headerCols := []core.Col{
+		col.New(1).Add(text.New("Rank", headerProps)),
+		col.New(3).Add(text.New("Nama", headerProps)),
+		col.New(clubWidth).Add(text.New("Club / Domisili", headerProps)),
+		col.New(1).Add(text.New("Poin", headerProps)),
+	}
+	for _, tb := range tieBreaks {
+		headerCols = append(headerCols, col.New(tieBreakWidth).Add(text.New(tieBreakLabels[tb], headerProps)))
+	}
+	m.AddRows(row.New(12).Add(headerCols...))
+
 	// Add player standings data
+	byeNote := false
 	for i, player := range standings {
 		rank := fmt.Sprintf("#%d", i+1)
 		points := fmt.Sprintf("%.1f", player.Score)
-		buchholz := fmt.Sprintf("%.1f", player.Buchholz)
-		progressive := fmt.Sprintf("%.1f", player.ProgressiveScore)
-		
+		// Scores that include bye points are marked, see the note below the table
+		if len(player.ByeRounds) > 0 {
+			points += "*"
+			byeNote = true
+		}
+
 		// Handle empty club field
 		club := player.Club
 		if club == "" {
 			club = "-"
 		}
 
+		cellProps := props.Text{
+			Top:   1,
+			Align: align.Center,
+			Size:  9,
+		}
+		boldProps := cellProps
+		boldProps.Style = fontstyle.Bold
+
+		// The leader is printed in bold throughout
+		nameProps := cellProps
+		if i == 0 {
+			nameProps = boldProps
+		}
+
+		cols := []core.Col{
+			col.New(1).Add(text.New(rank, boldProps)),
+			col.New(3).Add(text.New(player.Name, nameProps)),
+			col.New(clubWidth).Add(text.New(club, nameProps)),
+			col.New(1).Add(text.New(points, boldProps)),
+		}
+		for _, tb := range tieBreaks {
+			value, _ := tieBreakValue(player, tb)
+			format := "%.1f"
+			if tb == TieBreakARO {
+				format = "%.0f"
+			}
+			cols = append(cols, col.New(tieBreakWidth).Add(text.New(fmt.Sprintf(format, value), nameProps)))
+		}
+
+		r := row.New(10).Add(cols...)
+		// Highlight the leader's row
+		if i == 0 {
+			r = r.WithStyle(&props.Cell{
+				BackgroundColor: &props.Color{Red: 255, Green: 236, Blue: 179},
+			})
+		}
+		m.AddRows(r)
+	}
+
+	if byeNote {
 		m.AddRows(
-			row.New(10).Add(
-				col.New(1).Add(
-					text.New(rank, props.Text{
-						Top:   1,
-						Style: fontstyle.Bold,
-						Align: align.Center,
-						Size:  9,
-					}),
-				),
-				col.New(3).Add(
-					text.New(player.Name, props.Text{
-						Top:   1,
-						Align: align.Center,
-						Size:  9,
-					}),
-				),
-				col.New(1).Add(
-					text.New(points, props.Text{
-						Top:   1,
-						Style: fontstyle.Bold,
-						Align: align.Center,
-						Size:  9,
-					}),
-				),
-				col.New(2).Add(
-					text.New(buchholz, props.Text{
-						Top:   1,
-						Align: align.Center,
-						Size:  9,
-					}),
-				),
-				col.New(2).Add(
-					text.New(progressive, props.Text{
-						Top:   1,
-						Align: align.Center,
-						Size:  9,
-					}),
-				),
-				col.New(3).Add(
-					text.New(club, props.Text{
-						Top:   1,
-						Align: align.Center,
-						Size:  9,
+			row.New(8).Add(
+				col.New(12).Add(
+					text.New("* Poin termasuk bye", props.Text{
+						Top:   3,
+						Align: align.Left,
+						Size:  8,
 					}),
 				),
 			),
@@ -1853,27 +5148,8 @@ func ExportAllRoundsPairingsToPDF(t *model.Tournament) ([]byte, error) {
 
 		// Add match data rows
 		for _, match := range matches {
-			whitePlayer := getPlayerName(players, match.WhiteID)
-			blackPlayer := getPlayerName(players, match.BlackID)
-
-			if match.PlayerB_ID == ByePlayerID {
-				blackPlayer = "BYE"
-			}
-
-			whitePoints := "0.0"
-			blackPoints := "0.0"
-
-			if p, exists := playerMap[match.WhiteID]; exists {
-				whitePoints = fmt.Sprintf("%.1f", p.Score)
-			}
-
-			if match.BlackID != "" && match.PlayerB_ID != ByePlayerID {
-				if p, exists := playerMap[match.BlackID]; exists {
-					blackPoints = fmt.Sprintf("%.1f", p.Score)
-				}
-			} else if match.PlayerB_ID == ByePlayerID {
-				blackPoints = "-"
-			}
+			// Names and current points; BYE is printed opposite the real player on either side
+			whitePlayer, whitePoints, blackPlayer, blackPoints := pairingRowSides(players, playerMap, match)
 
 			m.AddRows(
 				row.New(8).Add(
@@ -0,0 +1,483 @@
+package tournament
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"xchess-desktop/internal/model"
+)
+
+func TestRecordMatchResultRejectsFutureRound(t *testing.T) {
+	tour := newTestTournament(t, 4)
+	playRound(t, tour)
+
+	err := RecordMatchResult(tour, 2, 1, model.ResultAWin)
+	if err == nil {
+		t.Fatal("recording into round 2 while the current round is 1 succeeded")
+	}
+	if !strings.Contains(err.Error(), "current round is 1") {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestRecordMatchResultEditsHistoricalRound(t *testing.T) {
+	tour := newTestTournament(t, 4)
+	first := playRound(t, tour)
+	playRound(t, tour)
+
+	// Turn the first game of round 1 from a win for A into a win for B
+	m := first[0]
+	before := playerByID(t, tour, m.PlayerA_ID).Score
+	if err := RecordMatchResult(tour, 1, m.TableNumber, model.ResultBWin); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := playerByID(t, tour, m.PlayerA_ID).Score; got != before-1 {
+		t.Errorf("%s score = %.1f after losing round 1, want %.1f", m.PlayerA_ID, got, before-1)
+	}
+	players, err := tour.GetPlayers()
+	if err != nil {
+		t.Fatal(err)
+	}
+	total := 0.0
+	for _, p := range players {
+		total += p.Score
+	}
+	if total != 4 {
+		t.Errorf("total score = %.1f after two rounds of two games, want 4", total)
+	}
+}
+
+func TestPreviewNextRoundMatchesFirstRound(t *testing.T) {
+	tour := newTestTournament(t, 6)
+	tour.PairingSeed = 0
+
+	// The preview itself has no side effects
+	before := *tour
+	if _, err := PreviewNextRound(tour, SwissToolAdapter{}); err != nil {
+		t.Fatal(err)
+	}
+	if tour.PairingSeed != 0 || tour.CurrentRound != 0 || string(tour.PlayersData) != string(before.PlayersData) ||
+		string(tour.RoundsData) != string(before.RoundsData) || string(tour.EventsData) != string(before.EventsData) {
+		t.Fatal("PreviewNextRound changed the tournament")
+	}
+
+	// With the seed locked, NextRound pairs what the preview showed
+	seed, err := LockPairingSeed(tour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if seed == 0 || tour.PairingSeed != seed {
+		t.Fatalf("LockPairingSeed returned %d, tournament seed %d", seed, tour.PairingSeed)
+	}
+	preview, err := PreviewNextRound(tour, SwissToolAdapter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := AdvanceToNextRound(tour, SwissToolAdapter{}); err != nil {
+		t.Fatal(err)
+	}
+	got := currentMatches(t, tour)
+	if len(got) != len(preview.Matches) {
+		t.Fatalf("round 1 has %d matches, preview had %d", len(got), len(preview.Matches))
+	}
+	for i := range got {
+		if got[i].PlayerA_ID != preview.Matches[i].PlayerA_ID || got[i].PlayerB_ID != preview.Matches[i].PlayerB_ID {
+			t.Errorf("table %d: paired %s-%s, preview showed %s-%s", got[i].TableNumber,
+				got[i].PlayerA_ID, got[i].PlayerB_ID, preview.Matches[i].PlayerA_ID, preview.Matches[i].PlayerB_ID)
+		}
+	}
+}
+
+func TestRecommendRounds(t *testing.T) {
+	tests := []struct {
+		players int
+		want    int
+	}{
+		{2, 3},
+		{3, 3},
+		{8, 3},
+		{9, 4},
+		{16, 4},
+		{17, 5},
+		{100, 7},
+	}
+	for _, tt := range tests {
+		if got := RecommendRounds(tt.players); got != tt.want {
+			t.Errorf("RecommendRounds(%d) = %d, want %d", tt.players, got, tt.want)
+		}
+	}
+}
+
+func TestAdvanceStopsAtRoundsTotal(t *testing.T) {
+	tour := newTestTournament(t, 8)
+	if tour.RoundsTotal != 3 {
+		t.Fatalf("RoundsTotal = %d, want the recommended 3", tour.RoundsTotal)
+	}
+	for i := 0; i < 3; i++ {
+		playRound(t, tour)
+	}
+	if tour.Status != StatusComplete {
+		t.Fatalf("status after the final round = %s, want %s", tour.Status, StatusComplete)
+	}
+	if err := AdvanceToNextRound(tour, SwissToolAdapter{}); err == nil {
+		t.Fatal("AdvanceToNextRound paired a round after the final one")
+	}
+	if _, err := PreviewNextRound(tour, SwissToolAdapter{}); err == nil {
+		t.Fatal("PreviewNextRound previewed a round after the final one")
+	}
+
+	// Extending the event reopens it and allows another round with results
+	if err := SetRoundsTotal(tour, 4); err != nil {
+		t.Fatal(err)
+	}
+	if tour.Status != StatusActive {
+		t.Fatalf("status after extending = %s, want %s", tour.Status, StatusActive)
+	}
+	playRound(t, tour)
+	if tour.CurrentRound != 4 || tour.Status != StatusComplete {
+		t.Errorf("after round 4: current round %d, status %s", tour.CurrentRound, tour.Status)
+	}
+	if err := SetRoundsTotal(tour, 3); err == nil {
+		t.Error("SetRoundsTotal accepted fewer rounds than already paired")
+	}
+}
+
+func TestRatingFirstRoundAvoidsForbiddenPairs(t *testing.T) {
+	tour := &model.Tournament{FirstRoundMethod: FirstRoundRating}
+	players := []model.Player{
+		{ID: "p1", Name: "Player 1", Rating: 2400},
+		{ID: "p2", Name: "Player 2", Rating: 2300},
+		{ID: "p3", Name: "Player 3", Rating: 2200},
+		{ID: "p4", Name: "Player 4", Rating: 2100},
+	}
+	if err := InitializeTournament(tour, "Test Open", "Test event", players); err != nil {
+		t.Fatal(err)
+	}
+	// p1 vs p3 is the natural top-half/bottom-half pairing on table 1
+	tour.ForbiddenPairs = [][2]string{{"p1", "p3"}}
+	if err := AdvanceToNextRound(tour, SwissToolAdapter{}); err != nil {
+		t.Fatal(err)
+	}
+	got := map[[2]string]bool{}
+	for _, m := range currentMatches(t, tour) {
+		got[[2]string{m.PlayerA_ID, m.PlayerB_ID}] = true
+		got[[2]string{m.PlayerB_ID, m.PlayerA_ID}] = true
+	}
+	if got[[2]string{"p1", "p3"}] {
+		t.Fatal("round 1 paired the forbidden pair p1 vs p3")
+	}
+	if !got[[2]string{"p1", "p4"}] || !got[[2]string{"p2", "p3"}] {
+		t.Errorf("round 1 pairings = %v, want p1-p4 and p2-p3", got)
+	}
+}
+
+func TestGetStandingsLeavesPlayersDataUnchanged(t *testing.T) {
+	tour := newTestTournament(t, 6)
+	playRound(t, tour)
+	playRound(t, tour)
+
+	// Clear the stored tie-breaks so a write-back from GetStandings would show
+	players, err := tour.GetPlayers()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range players {
+		players[i].Buchholz = 0
+	}
+	if err := tour.SetPlayers(players); err != nil {
+		t.Fatal(err)
+	}
+	before := string(tour.PlayersData)
+
+	standings, err := GetStandings(tour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := string(tour.PlayersData); got != before {
+		t.Fatalf("GetStandings changed PlayersData:\nbefore %s\nafter  %s", before, got)
+	}
+	computed := false
+	for _, p := range standings {
+		if p.Buchholz != 0 {
+			computed = true
+		}
+	}
+	if !computed {
+		t.Error("GetStandings returned no Buchholz values")
+	}
+}
+
+func TestByesSpreadEvenly(t *testing.T) {
+	tour := newTestTournament(t, 5)
+	tour.RoundsTotal = 5
+
+	byes := map[string]int{}
+	for round := 1; round <= 5; round++ {
+		for _, m := range playRound(t, tour) {
+			if isBye(m) {
+				byes[byeRecipient(m)]++
+			}
+		}
+	}
+	for i := 1; i <= 5; i++ {
+		id := fmt.Sprintf("p%d", i)
+		if byes[id] != 1 {
+			t.Errorf("%s had %d byes in 5 rounds, want 1", id, byes[id])
+		}
+	}
+}
+
+func TestByeByRatingGivesUnratedPlayerTheBye(t *testing.T) {
+	tour := &model.Tournament{FirstRoundMethod: FirstRoundRating, ByeByRating: true}
+	players := []model.Player{
+		{ID: "p1", Name: "Player 1", Rating: 2000},
+		{ID: "p2", Name: "Player 2", Rating: 1800},
+		{ID: "p3", Name: "Player 3", Rating: 1200},
+		{ID: "p4", Name: "Player 4"},
+		{ID: "p5", Name: "Player 5"},
+	}
+	if err := InitializeTournament(tour, "Test Open", "Test event", players); err != nil {
+		t.Fatal(err)
+	}
+
+	// Round 1 by rating: the last unrated player by name sits out
+	for _, m := range playRound(t, tour) {
+		if isBye(m) && byeRecipient(m) != "p5" {
+			t.Fatalf("round 1 bye went to %s, want p5", byeRecipient(m))
+		}
+	}
+	// Round 2: the remaining unrated player goes before the lowest rated one
+	for _, m := range playRound(t, tour) {
+		if isBye(m) && byeRecipient(m) != "p4" {
+			t.Errorf("round 2 bye went to %s, want the unrated p4", byeRecipient(m))
+		}
+	}
+}
+
+func TestFinalizeRoundSnapshotsThatRound(t *testing.T) {
+	tour := newTestTournament(t, 4)
+	playRound(t, tour)
+	playRound(t, tour)
+
+	if err := FinalizeRound(tour, 1); err != nil {
+		t.Fatal(err)
+	}
+	events, err := tour.GetEvents()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var detail struct {
+		Standings []StandingsEntry `json:"standings"`
+	}
+	for _, e := range events {
+		if e.Type == "ROUND_FINALIZED" && e.RoundNumber == 1 {
+			if err := json.Unmarshal(e.Details, &detail); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if len(detail.Standings) != 4 {
+		t.Fatalf("snapshot has %d entries, want 4", len(detail.Standings))
+	}
+	// Two decisive games were played in round 1, so two points are on the board
+	total := 0.0
+	for _, s := range detail.Standings {
+		total += s.Score
+	}
+	if total != 2 {
+		t.Errorf("round 1 snapshot holds %.1f points, want 2 (round 2 leaked in)", total)
+	}
+
+	live := 0.0
+	for _, id := range []string{"p1", "p2", "p3", "p4"} {
+		live += playerByID(t, tour, id).Score
+	}
+	if tour.CurrentRound != 2 || live != 4 {
+		t.Errorf("live tournament after FinalizeRound: round %d, %.1f points, want round 2 and 4", tour.CurrentRound, live)
+	}
+}
+
+func TestRestoreRoundRejectsChangedField(t *testing.T) {
+	tour := newTestTournament(t, 6)
+	playRound(t, tour)
+	if err := AdvanceToNextRound(tour, SwissToolAdapter{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := CancelCurrentRound(tour); err != nil {
+		t.Fatal(err)
+	}
+
+	// A player withdrew after the cancel: the old pairings are stale
+	withdrawn := *tour
+	if err := WithdrawPlayer(&withdrawn, "p1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := RestoreRound(&withdrawn, 2); err == nil {
+		t.Error("RestoreRound restored a round pairing a withdrawn player")
+	}
+
+	// A late entry is not in the cancelled round
+	added := *tour
+	players, err := added.GetPlayers()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := added.SetPlayers(append(players, model.Player{ID: "p7", Name: "Player 7"})); err != nil {
+		t.Fatal(err)
+	}
+	if err := RestoreRound(&added, 2); err == nil {
+		t.Error("RestoreRound restored a round missing an active player")
+	}
+
+	// With the field unchanged the round comes back
+	if err := RestoreRound(tour, 2); err != nil {
+		t.Fatal(err)
+	}
+	if tour.CurrentRound != 2 {
+		t.Errorf("current round after restore = %d, want 2", tour.CurrentRound)
+	}
+}
+
+func TestSmallFields(t *testing.T) {
+	tests := []struct {
+		players   int
+		initOK    bool
+		advanceOK bool
+	}{
+		{players: 0, initOK: false},
+		{players: 1, initOK: true, advanceOK: false},
+		{players: 2, initOK: true, advanceOK: true},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%d players", tt.players), func(t *testing.T) {
+			tour := &model.Tournament{PairingSeed: 1}
+			players := []model.Player{}
+			for i := 1; i <= tt.players; i++ {
+				players = append(players, model.Player{ID: fmt.Sprintf("p%d", i), Name: fmt.Sprintf("Player %d", i)})
+			}
+			err := InitializeTournament(tour, "Test Open", "Test event", players)
+			if (err == nil) != tt.initOK {
+				t.Fatalf("InitializeTournament error = %v, want ok %v", err, tt.initOK)
+			}
+			if !tt.initOK {
+				return
+			}
+
+			err = AdvanceToNextRound(tour, SwissToolAdapter{})
+			if (err == nil) != tt.advanceOK {
+				t.Fatalf("AdvanceToNextRound error = %v, want ok %v", err, tt.advanceOK)
+			}
+			if !tt.advanceOK {
+				if tour.CurrentRound != 0 || tour.Status != StatusSetup {
+					t.Errorf("failed round 1 left round %d, status %s", tour.CurrentRound, tour.Status)
+				}
+				return
+			}
+			matches := currentMatches(t, tour)
+			if len(matches) != 1 || isBye(matches[0]) {
+				t.Errorf("round 1 of 2 players = %+v, want a single game", matches)
+			}
+		})
+	}
+}
+
+// Regression: a player must never get a second pairing bye while an active player has had none,
+// not even in the last-resort attempt that allows a rematch.
+func TestNoRepeatByeBeforeEveryoneHadOne(t *testing.T) {
+	for seed := int64(1); seed <= 5; seed++ {
+		t.Run(fmt.Sprintf("seed %d", seed), func(t *testing.T) {
+			tour := newTestTournament(t, 7)
+			tour.PairingSeed = seed
+			tour.RoundsTotal = 7
+
+			byes := map[string]int{}
+			for round := 1; round <= 7; round++ {
+				for _, m := range playRound(t, tour) {
+					if !isBye(m) {
+						continue
+					}
+					id := byeRecipient(m)
+					for i := 1; i <= 7; i++ {
+						if other := fmt.Sprintf("p%d", i); byes[other] < byes[id] {
+							t.Fatalf("round %d: %s got bye %d while %s had %d", round, id, byes[id]+1, other, byes[other])
+						}
+					}
+					byes[id]++
+				}
+			}
+		})
+	}
+}
+
+func TestForfeitsCountInTiebreaksByDefault(t *testing.T) {
+	tour := newTestTournament(t, 4)
+	playRound(t, tour)
+	if err := AdvanceToNextRound(tour, SwissToolAdapter{}); err != nil {
+		t.Fatal(err)
+	}
+	matches := currentMatches(t, tour)
+	if err := RecordMatchResult(tour, 2, matches[0].TableNumber, model.ResultBForfeit); err != nil {
+		t.Fatal(err)
+	}
+	if err := RecordMatchResult(tour, 2, matches[1].TableNumber, model.ResultAWin); err != nil {
+		t.Fatal(err)
+	}
+	winner, loser := matches[0].PlayerA_ID, matches[0].PlayerB_ID
+
+	buchholz := func() float64 {
+		standings, err := GetStandings(tour)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, p := range standings {
+			if p.ID == winner {
+				return p.Buchholz
+			}
+		}
+		t.Fatalf("player %s not in the standings", winner)
+		return 0
+	}
+
+	// InitializeTournament counts forfeits: the forfeit loser is one of the winner's opponents
+	if !tour.CountForfeitsInTiebreaks {
+		t.Fatal("InitializeTournament did not turn CountForfeitsInTiebreaks on")
+	}
+	counted := buchholz()
+	tour.CountForfeitsInTiebreaks = false
+	excluded := buchholz()
+	if want := counted - playerByID(t, tour, loser).Score; excluded != want {
+		t.Errorf("strict FIDE Buchholz = %.1f, want %.1f (%.1f without %s)", excluded, want, counted, loser)
+	}
+}
+
+func TestAcceleratedTopGroupByStartRank(t *testing.T) {
+	// Entered alternating weak and strong, so the top seeds are spread through PlayersData
+	tour := &model.Tournament{Accelerated: true, PairingSeed: 1}
+	players := make([]model.Player, 0, 8)
+	for i := 1; i <= 8; i++ {
+		rating := 1000 + 10*i
+		if i%2 == 0 {
+			rating += 1000
+		}
+		players = append(players, model.Player{ID: fmt.Sprintf("p%d", i), Name: fmt.Sprintf("Player %d", i), Rating: rating})
+	}
+	if err := InitializeTournament(tour, "Test Open", "Test event", players); err != nil {
+		t.Fatal(err)
+	}
+	if err := AdvanceToNextRound(tour, SwissToolAdapter{}); err != nil {
+		t.Fatal(err)
+	}
+
+	// The top group is start ranks 1-4 (p2, p4, p6, p8); each group is paired within itself
+	top := func(id string) bool {
+		return playerByID(t, tour, id).StartRank <= 4
+	}
+	for _, m := range currentMatches(t, tour) {
+		if top(m.PlayerA_ID) != top(m.PlayerB_ID) {
+			t.Errorf("round 1 pairs %s against %s across the accelerated groups", m.PlayerA_ID, m.PlayerB_ID)
+		}
+	}
+}
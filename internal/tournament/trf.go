@@ -0,0 +1,151 @@
+package tournament
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"xchess-desktop/internal/model"
+)
+
+// ExportTRF generates a FIDE TRF report of the tournament for rating submission.
+// It writes the 012 (name), 042/052 (start/end date) and 062 (player count) header lines,
+// followed by one 001 line per player with start rank, name, rating, points, rank and
+// per-round opponent/color/result triplets. Players are listed by StartRank.
+func ExportTRF(t *model.Tournament) ([]byte, error) {
+	players, err := t.GetPlayers()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get players: %w", err)
+	}
+	if len(players) == 0 {
+		return nil, fmt.Errorf("no players found in tournament")
+	}
+
+	rounds, err := t.GetRounds()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rounds: %w", err)
+	}
+
+	standings, err := GetStandings(t)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get standings: %w", err)
+	}
+
+	// Start ranks and final ranks by player ID
+	startRank := startRanks(players)
+	sort.SliceStable(players, func(i, j int) bool {
+		return startRank[players[i].ID] < startRank[players[j].ID]
+	})
+	finalRank := make(map[string]int, len(standings))
+	scores := make(map[string]float64, len(standings))
+	for i, p := range standings {
+		finalRank[p.ID] = i + 1
+		scores[p.ID] = p.Score
+	}
+
+	// Only rounds that have been played so far, in order
+	played := make([]model.Round, 0, len(rounds))
+	for _, r := range rounds {
+		if r.RoundNumber <= t.CurrentRound {
+			played = append(played, r)
+		}
+	}
+	sort.SliceStable(played, func(i, j int) bool {
+		return played[i].RoundNumber < played[j].RoundNumber
+	})
+
+	var buf bytes.Buffer
+
+	// Tournament header lines
+	fmt.Fprintf(&buf, "012 %s\n", t.Title)
+	if !t.StartTime.IsZero() {
+		fmt.Fprintf(&buf, "042 %s\n", t.StartTime.Format("2006/01/02"))
+	}
+	if t.EndTime != nil {
+		fmt.Fprintf(&buf, "052 %s\n", t.EndTime.Format("2006/01/02"))
+	}
+	fmt.Fprintf(&buf, "062 %d\n", len(players))
+
+	// Player lines
+	for _, p := range players {
+		// The name field is 33 characters; cut on a rune boundary so UTF-8 names stay valid
+		name := p.Name
+		if runes := []rune(name); len(runes) > 33 {
+			name = string(runes[:33])
+		}
+		rating := ""
+		if p.Rating > 0 {
+			rating = fmt.Sprintf("%d", p.Rating)
+		}
+
+		// 001 SSSS sTTT NAME(33) RRRR FFF IIIIIIIIIII BBBB/BB/BB PPPP RRRR
+		fmt.Fprintf(&buf, "001 %4d %1s%3s %-33s %4s %3s %11s %10s %4.1f %4d",
+			startRank[p.ID], "", "", name, rating, "", "", "", scores[p.ID], finalRank[p.ID])
+
+		for _, r := range played {
+			fmt.Fprintf(&buf, "  %s", trfRoundEntry(t, r, p.ID, startRank))
+		}
+		buf.WriteString("\n")
+	}
+
+	return buf.Bytes(), nil
+}
+
+// trfRoundEntry formats the 8-character "oooo c r" block of a player's game in a round.
+// Unpaired players and games without a result are left blank.
+func trfRoundEntry(t *model.Tournament, r model.Round, playerID string, startRank map[string]int) string {
+	const blank = "        "
+
+	for _, m := range r.Matches {
+		if m.PlayerA_ID != playerID && m.PlayerB_ID != playerID {
+			continue
+		}
+		if m.Result == "" {
+			return blank
+		}
+
+		opponentID := m.PlayerB_ID
+		own, other := m.ScoreA, m.ScoreB
+		if m.PlayerB_ID == playerID {
+			opponentID = m.PlayerA_ID
+			own, other = m.ScoreB, m.ScoreA
+		}
+
+		// Byes (either slot): full point (U), half point (H) or zero point (Z)
+		if isBye(m) {
+			code := "U"
+			switch {
+			case own == 0:
+				code = "Z"
+			case own < 1.0:
+				code = "H"
+			}
+			return fmt.Sprintf("0000 - %s", code)
+		}
+
+		color := "-"
+		if m.WhiteID == playerID {
+			color = "w"
+		} else if m.BlackID == playerID {
+			color = "b"
+		}
+
+		result := "="
+		if own > other {
+			result = "1"
+		} else if own < other {
+			result = "0"
+		}
+		// Forfeits: "+" for the present player, "-" for the absent one (both on a double forfeit)
+		if m.Forfeit {
+			result = "-"
+			if own > other {
+				result = "+"
+			}
+		}
+
+		return fmt.Sprintf("%4d %s %s", startRank[opponentID], color, result)
+	}
+
+	return blank
+}
@@ -0,0 +1,80 @@
+package tournament
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"xchess-desktop/internal/model"
+)
+
+var updateGolden = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+// trfTournament is a fixed two-round event covering a win, a draw, a forfeit, a half-point
+// bye and a name longer than the 33-character TRF field.
+func trfTournament(t *testing.T) *model.Tournament {
+	t.Helper()
+	tour := &model.Tournament{}
+	players := []model.Player{
+		{ID: "a", Name: "Anand", Rating: 2750},
+		{ID: "b", Name: "Bärbel Śliwińska-Łukaszewiczówna-Øster", Rating: 2400},
+		{ID: "c", Name: "Carlsen", Rating: 2830},
+		{ID: "d", Name: "Ding", Rating: 2780},
+		{ID: "e", Name: "Erigaisi"},
+	}
+	if err := InitializeTournament(tour, "Golden Open", "TRF export", players); err != nil {
+		t.Fatal(err)
+	}
+	tour.StartTime = time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 3, 2, 18, 0, 0, 0, time.UTC)
+	tour.EndTime = &end
+
+	rounds := []model.Round{
+		{RoundNumber: 1, IsComplete: true, Matches: []model.Match{
+			{RoundNumber: 1, TableNumber: 1, PlayerA_ID: "c", PlayerB_ID: "b", WhiteID: "c", BlackID: "b", Result: model.ResultAWin, ScoreA: 1},
+			{RoundNumber: 1, TableNumber: 2, PlayerA_ID: "d", PlayerB_ID: "a", WhiteID: "d", BlackID: "a", Result: model.ResultDraw, ScoreA: 0.5, ScoreB: 0.5},
+			{RoundNumber: 1, TableNumber: 3, PlayerA_ID: "e", PlayerB_ID: ByePlayerID, WhiteID: "e", Result: model.ResultByeA, ScoreA: 0.5, ByeValue: 0.5},
+		}},
+		{RoundNumber: 2, IsComplete: true, Matches: []model.Match{
+			{RoundNumber: 2, TableNumber: 1, PlayerA_ID: "a", PlayerB_ID: "c", WhiteID: "a", BlackID: "c", Result: model.ResultBWin, ScoreB: 1},
+			{RoundNumber: 2, TableNumber: 2, PlayerA_ID: "e", PlayerB_ID: "d", WhiteID: "e", BlackID: "d", Result: model.ResultAForfeit, ScoreB: 1, Forfeit: true},
+			{RoundNumber: 2, TableNumber: 3, PlayerA_ID: "b", PlayerB_ID: ByePlayerID, WhiteID: "b", Result: model.ResultByeA, ScoreA: 1, ByeValue: 1},
+		}},
+	}
+	if err := tour.SetRounds(rounds); err != nil {
+		t.Fatal(err)
+	}
+	tour.CurrentRound = 2
+	if err := RecomputePlayersFromRounds(tour); err != nil {
+		t.Fatal(err)
+	}
+	return tour
+}
+
+func TestExportTRFGolden(t *testing.T) {
+	got, err := ExportTRF(trfTournament(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	golden := filepath.Join("testdata", "export.trf")
+	if *updateGolden {
+		if err := os.WriteFile(golden, got, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatalf("read golden file (run with -update to create it): %v", err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("ExportTRF mismatch\n got:\n%s\nwant:\n%s", got, want)
+	}
+	if !utf8.Valid(got) {
+		t.Error("ExportTRF produced invalid UTF-8")
+	}
+}
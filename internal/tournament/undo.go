@@ -0,0 +1,280 @@
+package tournament
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"xchess-desktop/internal/model"
+
+	"github.com/google/uuid"
+)
+
+// mutatingEvents are the event types that change tournament state.
+// Only the first four can be undone; the others block undo until new actions are recorded.
+var mutatingEvents = map[string]bool{
+	"MATCH_RESULT_RECORDED": true,
+	"RESULT_CHANGED":        true,
+	"RESULTS_SWAPPED":       true,
+	"ROUND_STARTED":         true,
+	"ROUND_CANCELLED":       true,
+	"ROUND_RESTORED":        true,
+	"ROUND_REPAIRED":        true,
+	"ROUND_REVERTED":        true,
+}
+
+// clearRedo drops the redo stack; called whenever a new action is performed.
+func clearRedo(t *model.Tournament) {
+	t.RedoData = nil
+}
+
+// appendRoundStartedEvent logs a ROUND_STARTED event carrying a snapshot of the new round.
+func appendRoundStartedEvent(t *model.Tournament, round model.Round) error {
+	events, _ := t.GetEvents()
+	detail := struct {
+		Round model.Round `json:"round"`
+	}{
+		Round: round,
+	}
+	detailJSON, _ := json.Marshal(detail)
+	events = append(events, model.Event{
+		EventID:     uuid.New(),
+		Type:        "ROUND_STARTED",
+		Timestamp:   time.Now(),
+		RoundNumber: round.RoundNumber,
+		TableNumber: 0, // Not applicable for round-level events
+		Details:     detailJSON,
+	})
+	return t.SetEvents(events)
+}
+
+// UndoLastAction reverses the most recent mutating event and moves it onto the redo stack.
+// Recorded results are restored to what the match held before, swapped results are swapped
+// back and a started round is removed. Cancelled or reverted rounds cannot be undone.
+func UndoLastAction(t *model.Tournament) error {
+	events, err := t.GetEvents()
+	if err != nil {
+		return err
+	}
+	// Read the stack first: the reversal goes through regular mutations, which clear it
+	redo, err := t.GetRedoEvents()
+	if err != nil {
+		return err
+	}
+
+	last := -1
+	for i := len(events) - 1; i >= 0; i-- {
+		if mutatingEvents[events[i].Type] {
+			last = i
+			break
+		}
+	}
+	if last == -1 {
+		return fmt.Errorf("nothing to undo")
+	}
+	e := events[last]
+
+	switch e.Type {
+	case "MATCH_RESULT_RECORDED", "RESULT_CHANGED":
+		var detail struct {
+			Match    model.Match `json:"match"`
+			Previous model.Match `json:"previous"`
+		}
+		if err := json.Unmarshal(e.Details, &detail); err != nil {
+			return fmt.Errorf("failed to read event details: %w", err)
+		}
+		if err := restoreMatchResult(t, e.RoundNumber, e.TableNumber, detail.Match, detail.Previous); err != nil {
+			return err
+		}
+	case "RESULTS_SWAPPED":
+		var detail struct {
+			Tables []int `json:"tables"`
+		}
+		if err := json.Unmarshal(e.Details, &detail); err != nil {
+			return fmt.Errorf("failed to read event details: %w", err)
+		}
+		// Swapping the same tables again restores the original results
+		if err := SwapResultsInRound(t, e.RoundNumber, detail.Tables); err != nil {
+			return err
+		}
+	case "ROUND_STARTED":
+		if err := removeStartedRound(t, e.RoundNumber); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("cannot undo %s", e.Type)
+	}
+
+	// Drop the undone event, plus anything the reversal itself logged
+	remaining := make([]model.Event, 0, len(events)-1)
+	remaining = append(remaining, events[:last]...)
+	remaining = append(remaining, events[last+1:]...)
+	if err := t.SetEvents(remaining); err != nil {
+		return err
+	}
+
+	return t.SetRedoEvents(append(redo, e))
+}
+
+// RedoLastAction reapplies the most recently undone event.
+func RedoLastAction(t *model.Tournament) error {
+	redo, err := t.GetRedoEvents()
+	if err != nil {
+		return err
+	}
+	if len(redo) == 0 {
+		return fmt.Errorf("nothing to redo")
+	}
+	e := redo[len(redo)-1]
+	redo = redo[:len(redo)-1]
+
+	switch e.Type {
+	case "MATCH_RESULT_RECORDED", "RESULT_CHANGED":
+		var detail struct {
+			Match model.Match `json:"match"`
+		}
+		if err := json.Unmarshal(e.Details, &detail); err != nil {
+			return fmt.Errorf("failed to read event details: %w", err)
+		}
+		if err := RecordMatchResult(t, e.RoundNumber, e.TableNumber, detail.Match.Result); err != nil {
+			return err
+		}
+	case "RESULTS_SWAPPED":
+		var detail struct {
+			Tables []int `json:"tables"`
+		}
+		if err := json.Unmarshal(e.Details, &detail); err != nil {
+			return fmt.Errorf("failed to read event details: %w", err)
+		}
+		if err := SwapResultsInRound(t, e.RoundNumber, detail.Tables); err != nil {
+			return err
+		}
+	case "ROUND_STARTED":
+		var detail struct {
+			Round model.Round `json:"round"`
+		}
+		if err := json.Unmarshal(e.Details, &detail); err != nil {
+			return fmt.Errorf("failed to read event details: %w", err)
+		}
+		if err := restoreStartedRound(t, detail.Round); err != nil {
+			return err
+		}
+		events, _ := t.GetEvents()
+		if err := t.SetEvents(append(events, e)); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("cannot redo %s", e.Type)
+	}
+
+	// Reapplying goes through the regular mutations, which clear the stack; keep the rest of it
+	return t.SetRedoEvents(redo)
+}
+
+// restoreMatchResult puts a match back to its previous result, provided it still holds
+// the result the event recorded.
+func restoreMatchResult(t *model.Tournament, roundNumber int, tableNumber int, recorded model.Match, previous model.Match) error {
+	if roundNumber > t.CurrentRound {
+		return fmt.Errorf("cannot undo: round %d is not active", roundNumber)
+	}
+	if err := ensureNotComplete(t); err != nil {
+		return err
+	}
+
+	rounds, err := t.GetRounds()
+	if err != nil {
+		return err
+	}
+
+	match, targetRound, err := findMatchIn(rounds, roundNumber, tableNumber)
+	if err != nil {
+		return err
+	}
+	if match.Result != recorded.Result || match.ScoreA != recorded.ScoreA || match.ScoreB != recorded.ScoreB {
+		return fmt.Errorf("cannot undo: result for round %d, table %d has changed since it was recorded", roundNumber, tableNumber)
+	}
+
+	match.Result = previous.Result
+	match.ScoreA = previous.ScoreA
+	match.ScoreB = previous.ScoreB
+	match.Forfeit = previous.Forfeit
+	match.ResultRecordedAt = previous.ResultRecordedAt
+
+	allComplete := true
+	for _, m := range targetRound.Matches {
+		if m.Result == "" {
+			allComplete = false
+			break
+		}
+	}
+	targetRound.IsComplete = allComplete
+
+	if err := t.SetRounds(rounds); err != nil {
+		return err
+	}
+	if err := RecomputePlayersFromRounds(t); err != nil {
+		return err
+	}
+	return UpdateStandings(t)
+}
+
+// removeStartedRound removes the current round when it is the one the event started.
+func removeStartedRound(t *model.Tournament, roundNumber int) error {
+	if roundNumber != t.CurrentRound {
+		return fmt.Errorf("cannot undo: round %d is not the current round", roundNumber)
+	}
+
+	rounds, err := t.GetRounds()
+	if err != nil {
+		return err
+	}
+	filtered := make([]model.Round, 0, len(rounds))
+	for _, r := range rounds {
+		if r.RoundNumber != roundNumber {
+			filtered = append(filtered, r)
+		}
+	}
+	if err := t.SetRounds(filtered); err != nil {
+		return err
+	}
+
+	t.CurrentRound--
+	if err := RecomputePlayersFromRounds(t); err != nil {
+		return err
+	}
+	return UpdateStandings(t)
+}
+
+// restoreStartedRound puts an undone round back as the next round.
+func restoreStartedRound(t *model.Tournament, round model.Round) error {
+	if round.RoundNumber != t.CurrentRound+1 {
+		return fmt.Errorf("cannot redo: round %d does not follow the current round %d", round.RoundNumber, t.CurrentRound)
+	}
+
+	rounds, err := t.GetRounds()
+	if err != nil {
+		return err
+	}
+
+	// Drop any stale rounds beyond the current one before restoring
+	filtered := make([]model.Round, 0, len(rounds)+1)
+	for _, r := range rounds {
+		if r.RoundNumber <= t.CurrentRound {
+			filtered = append(filtered, r)
+		}
+	}
+	filtered = append(filtered, round)
+	sort.SliceStable(filtered, func(i, j int) bool {
+		return filtered[i].RoundNumber < filtered[j].RoundNumber
+	})
+	if err := t.SetRounds(filtered); err != nil {
+		return err
+	}
+
+	t.CurrentRound = round.RoundNumber
+	if err := RecomputePlayersFromRounds(t); err != nil {
+		return err
+	}
+	return UpdateStandings(t)
+}
@@ -0,0 +1,176 @@
+package tournament
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"xchess-desktop/internal/model"
+
+	"github.com/google/uuid"
+)
+
+// WithdrawPlayer withdraws a player from the rest of the tournament: they are left out of
+// every later pairing. A pending game in the current round is forfeited to the opponent
+// (FORFEIT_AWARDED); a pending bye of the withdrawn player becomes a zero-point bye.
+// Results already recorded are kept. WithdrawnInRound records the current round: games of
+// later rounds never count (see RecomputePlayersFromRounds).
+func WithdrawPlayer(t *model.Tournament, playerID string) error {
+	if err := ensureNotComplete(t); err != nil {
+		return err
+	}
+
+	players, err := t.GetPlayers()
+	if err != nil {
+		return err
+	}
+	var player *model.Player
+	for i := range players {
+		if players[i].ID == playerID {
+			player = &players[i]
+			break
+		}
+	}
+	if player == nil {
+		return fmt.Errorf("player not found: %s", playerID)
+	}
+	if player.Withdrawn {
+		return fmt.Errorf("player %s has already withdrawn", player.Name)
+	}
+	player.Withdrawn = true
+	player.WithdrawnInRound = t.CurrentRound
+	if err := t.SetPlayers(players); err != nil {
+		return err
+	}
+	if err := appendWithdrawalEvent(t, "PLAYER_WITHDRAWN", playerID, "", 0); err != nil {
+		return err
+	}
+
+	if t.CurrentRound == 0 {
+		return nil
+	}
+	rounds, err := t.GetRounds()
+	if err != nil {
+		return err
+	}
+	var pending *model.Match
+	for r := range rounds {
+		if rounds[r].RoundNumber != t.CurrentRound {
+			continue
+		}
+		for m := range rounds[r].Matches {
+			match := &rounds[r].Matches[m]
+			if match.Result == "" && (match.PlayerA_ID == playerID || match.PlayerB_ID == playerID) {
+				pending = match
+				break
+			}
+		}
+	}
+	if pending == nil {
+		return nil
+	}
+
+	if isBye(*pending) {
+		return forfeitBye(t, rounds, pending)
+	}
+
+	result, opponentID := model.ResultAForfeit, pending.PlayerB_ID
+	if pending.PlayerB_ID == playerID {
+		result, opponentID = model.ResultBForfeit, pending.PlayerA_ID
+	}
+	if err := RecordMatchResult(t, t.CurrentRound, pending.TableNumber, result); err != nil {
+		return err
+	}
+	return appendWithdrawalEvent(t, "FORFEIT_AWARDED", opponentID, playerID, pending.TableNumber)
+}
+
+// forfeitBye records match (a pending bye in rounds) as a zero-point bye and recomputes the players.
+func forfeitBye(t *model.Tournament, rounds []model.Round, match *model.Match) error {
+	recordedAt := time.Now()
+	match.Result = model.ResultByeA
+	if match.PlayerA_ID == ByePlayerID {
+		match.Result = model.ResultByeB
+	}
+	match.ScoreA, match.ScoreB = 0, 0
+	match.ResultRecordedAt = &recordedAt
+
+	allComplete := false
+	for r := range rounds {
+		if rounds[r].RoundNumber != match.RoundNumber {
+			continue
+		}
+		allComplete = true
+		for _, m := range rounds[r].Matches {
+			if m.Result == "" {
+				allComplete = false
+				break
+			}
+		}
+		rounds[r].IsComplete = allComplete
+	}
+
+	if err := t.SetRounds(rounds); err != nil {
+		return err
+	}
+	if err := RecomputePlayersFromRounds(t); err != nil {
+		return err
+	}
+	clearRedo(t)
+
+	if allComplete {
+		events, _ := t.GetEvents()
+		events = append(events, model.Event{
+			EventID:     uuid.New(),
+			Type:        "ROUND_COMPLETED",
+			Timestamp:   time.Now(),
+			RoundNumber: match.RoundNumber,
+			TableNumber: 0, // Not applicable for round-level events
+		})
+		if err := t.SetEvents(events); err != nil {
+			return err
+		}
+		// Completing the final round completes the tournament
+		if t.Status == StatusActive && t.RoundsTotal > 0 && match.RoundNumber == t.RoundsTotal {
+			if err := SetStatus(t, StatusComplete); err != nil {
+				return err
+			}
+			t.EndTime = &recordedAt
+		}
+	}
+
+	return UpdateStandings(t)
+}
+
+// activePlayers returns the players who have not withdrawn.
+func activePlayers(players []model.Player) []model.Player {
+	active := make([]model.Player, 0, len(players))
+	for _, p := range players {
+		if !p.Withdrawn {
+			active = append(active, p)
+		}
+	}
+	return active
+}
+
+// appendWithdrawalEvent logs PLAYER_WITHDRAWN (playerID withdrew) or FORFEIT_AWARDED
+// (playerID won on forfeit against the withdrawn opponentID) for the current round.
+func appendWithdrawalEvent(t *model.Tournament, eventType string, playerID string, opponentID string, tableNumber int) error {
+	events, _ := t.GetEvents()
+	detail := struct {
+		PlayerID   string `json:"player_id"`
+		OpponentID string `json:"opponent_id,omitempty"`
+	}{
+		PlayerID:   playerID,
+		OpponentID: opponentID,
+	}
+	detailJSON, _ := json.Marshal(detail)
+	events = append(events, model.Event{
+		EventID:     uuid.New(),
+		Type:        eventType,
+		Timestamp:   time.Now(),
+		RoundNumber: t.CurrentRound,
+		TableNumber: tableNumber,
+		Details:     detailJSON,
+	})
+	return t.SetEvents(events)
+}